@@ -0,0 +1,7 @@
+data "pinecone_backups" "movies" {
+  index_name = "movies"
+}
+
+output "ready_movies_backups" {
+  value = [for backup in data.pinecone_backups.movies.backups : backup.id if backup.status == "Ready"]
+}
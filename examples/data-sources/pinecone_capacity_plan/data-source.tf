@@ -0,0 +1,14 @@
+data "pinecone_capacity_plan" "docs" {
+  vector_count              = 2000000
+  dimension                 = 1536
+  metadata_bytes_per_vector = 256
+  target_qps                = 50
+}
+
+resource "pinecone_index" "docs" {
+  name      = "docs"
+  dimension = 1536
+  pod_type  = data.pinecone_capacity_plan.docs.pod_type
+  pods      = data.pinecone_capacity_plan.docs.pods
+  replicas  = data.pinecone_capacity_plan.docs.replicas
+}
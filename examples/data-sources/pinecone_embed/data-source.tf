@@ -0,0 +1,18 @@
+data "pinecone_embed" "question" {
+  model      = "llama-text-embed-v2"
+  inputs     = ["Which movie is about stealing secrets through dreams?"]
+  input_type = "query"
+  dimension  = 512
+}
+
+resource "pinecone_index" "movies" {
+  name      = "movies"
+  dimension = data.pinecone_embed.question.dimension
+}
+
+# Query the index with the embedding of the question.
+data "pinecone_query" "answer" {
+  index_name = pinecone_index.movies.name
+  vector     = data.pinecone_embed.question.embeddings[0].values
+  top_k      = 3
+}
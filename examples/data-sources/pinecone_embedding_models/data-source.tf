@@ -0,0 +1,10 @@
+data "pinecone_embedding_models" "llama" {
+  name = "llama-text-embed-v2"
+}
+
+# Size the index for the embeddings of the model instead of hard-coding it.
+resource "pinecone_index" "articles" {
+  name      = "articles"
+  dimension = data.pinecone_embedding_models.llama.models[0].default_dimension
+  metric    = data.pinecone_embedding_models.llama.models[0].default_metric
+}
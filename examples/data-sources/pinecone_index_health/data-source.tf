@@ -0,0 +1,17 @@
+# Fails the apply unless the index is ready and answers probe queries quickly.
+data "pinecone_index_health" "products" {
+  index_name         = "products"
+  probe_count        = 10
+  max_p95_latency_ms = 200
+
+  lifecycle {
+    postcondition {
+      condition     = self.healthy
+      error_message = "The products index is unhealthy."
+    }
+  }
+}
+
+output "products_p95_latency_ms" {
+  value = data.pinecone_index_health.products.p95_latency_ms
+}
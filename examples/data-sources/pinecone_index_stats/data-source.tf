@@ -0,0 +1,10 @@
+data "pinecone_index_stats" "products" {
+  index_name = "products"
+}
+
+check "products_seeded" {
+  assert {
+    condition     = lookup(data.pinecone_index_stats.products.namespaces, "catalog", 0) >= 1000
+    error_message = "The catalog namespace must hold at least 1000 vectors."
+  }
+}
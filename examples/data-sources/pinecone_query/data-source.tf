@@ -0,0 +1,15 @@
+# Checks after apply that a seeded document is its own closest match.
+data "pinecone_query" "smoke" {
+  index_name = "products"
+  namespace  = "catalog"
+  vector_id  = "doc-1"
+  top_k      = 3
+  filter     = jsonencode({ genre = { "$eq" = "drama" } })
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.matches) > 0 && self.matches[0].id == "doc-1"
+      error_message = "The index must return doc-1 as the closest match to itself."
+    }
+  }
+}
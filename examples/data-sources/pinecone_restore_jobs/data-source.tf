@@ -0,0 +1,5 @@
+data "pinecone_restore_jobs" "all" {}
+
+output "unfinished_restores" {
+  value = [for job in data.pinecone_restore_jobs.all.restore_jobs : job.index_name if job.status != "Completed"]
+}
@@ -0,0 +1,19 @@
+# A service account mints a key that only exists while Terraform runs.
+provider "pinecone" {
+  alias = "admin"
+}
+
+ephemeral "pinecone_api_key" "apply" {
+  provider   = pinecone.admin
+  project_id = var.project_id
+  name       = "terraform-apply"
+  roles      = ["ProjectEditor"]
+}
+
+provider "pinecone" {
+  api_key = ephemeral.pinecone_api_key.apply.value
+}
+
+variable "project_id" {
+  type = string
+}
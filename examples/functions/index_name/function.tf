@@ -0,0 +1,4 @@
+resource "pinecone_index" "team" {
+  name      = provider::pinecone::index_name("Team A / Staging") # "team-a-staging"
+  dimension = 1536
+}
@@ -0,0 +1,12 @@
+data "pinecone_query" "recent_dramas" {
+  index_name = "movies"
+  vector_id  = "movie-42"
+
+  # Fails at plan time with the path of any invalid operator, e.g. filter.$and[1].year.$between.
+  filter = provider::pinecone::metadata_filter({
+    "$and" = [
+      { genre = "drama" },
+      { year = { "$gte" = 2020 } },
+    ]
+  })
+}
@@ -0,0 +1,3 @@
+output "pod_class" {
+  value = provider::pinecone::parse_pod_type("p1.x2").class # "p1"
+}
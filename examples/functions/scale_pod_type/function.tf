@@ -0,0 +1,5 @@
+resource "pinecone_index" "search" {
+  name      = "search"
+  dimension = 1536
+  pod_type  = provider::pinecone::scale_pod_type("p1.x1") # "p1.x2"
+}
@@ -0,0 +1,8 @@
+variable "metric" {
+  type = string
+
+  validation {
+    condition     = provider::pinecone::validate_metric(var.metric)
+    error_message = "metric must be euclidean, cosine or dotproduct."
+  }
+}
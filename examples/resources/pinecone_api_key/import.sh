@@ -0,0 +1 @@
+terraform import pinecone_api_key.app 8f3c2a1e-6d4b-4b9a-a0e2-5c7d9e1f3b24
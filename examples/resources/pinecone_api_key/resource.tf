@@ -0,0 +1,23 @@
+resource "pinecone_project" "search" {
+  name = "search"
+}
+
+# Bump rotated to roll the key over: the new key is created before the old one is deleted.
+resource "pinecone_api_key" "app" {
+  project_id = pinecone_project.search.id
+  name       = "search-app"
+  roles      = ["DataPlaneEditor", "ControlPlaneViewer"]
+
+  rotation_triggers = {
+    rotated = "2026-10"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+output "app_api_key" {
+  value     = pinecone_api_key.app.value
+  sensitive = true
+}
@@ -0,0 +1 @@
+terraform import pinecone_assistant.docs internal-docs
@@ -0,0 +1,7 @@
+resource "pinecone_assistant" "docs" {
+  name         = "internal-docs"
+  instructions = "Answer from the internal documentation only, and link the page you used."
+  metadata = {
+    team = "search"
+  }
+}
@@ -0,0 +1,14 @@
+resource "pinecone_assistant" "docs" {
+  name = "internal-docs"
+}
+
+# Upload every markdown file of the docs directory. Editing a file uploads it again.
+resource "pinecone_assistant_file" "docs" {
+  for_each = fileset("${path.module}/docs", "**/*.md")
+
+  assistant_name = pinecone_assistant.docs.name
+  source         = "${path.module}/docs/${each.value}"
+  metadata = {
+    path = each.value
+  }
+}
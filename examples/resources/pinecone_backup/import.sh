@@ -0,0 +1 @@
+terraform import pinecone_backup.movies 670e8400-e29b-41d4-a716-446655440001
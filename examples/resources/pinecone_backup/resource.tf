@@ -0,0 +1,10 @@
+# Bump the description to take a new backup; the previous one is deleted.
+resource "pinecone_backup" "movies" {
+  source_index_name = "movies"
+  name              = "movies-before-migration"
+  description       = "Before the 2026-10 schema migration"
+}
+
+output "movies_backup_records" {
+  value = pinecone_backup.movies.record_count
+}
@@ -3,3 +3,13 @@ resource "pinecone_index" "test" {
   dimension = 1536
   metric    = "dotproduct"
 }
+
+# An index with integrated inference embeds the text of records with a hosted model.
+resource "pinecone_index" "articles" {
+  name = "articles"
+  embed = {
+    model           = "llama-text-embed-v2"
+    field_map       = { text = "chunk_text" }
+    read_parameters = { input_type = "query", truncate = "END" }
+  }
+}
@@ -0,0 +1,20 @@
+variable "legacy_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "pinecone_index" "products" {
+  name      = "products"
+  dimension = 1536
+}
+
+# Copies the index of the legacy project into the provider's project.
+resource "pinecone_index_copy" "products" {
+  source_index_name      = "products"
+  destination_index_name = pinecone_index.products.name
+
+  source = {
+    api_key     = var.legacy_api_key
+    environment = "us-west1-gcp"
+  }
+}
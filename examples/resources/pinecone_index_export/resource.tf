@@ -0,0 +1,10 @@
+# Writes backups/products/manifest.json and one JSONL file per namespace.
+resource "pinecone_index_export" "products" {
+  index_name = "products"
+  directory  = "${path.root}/backups/products"
+
+  # Export again on every change of the release.
+  triggers = {
+    release = var.release
+  }
+}
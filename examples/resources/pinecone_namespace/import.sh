@@ -0,0 +1 @@
+terraform import 'pinecone_namespace.tenant["acme"]' tenants/acme
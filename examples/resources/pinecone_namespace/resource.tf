@@ -0,0 +1,12 @@
+resource "pinecone_index" "tenants" {
+  name      = "tenants"
+  dimension = 1536
+}
+
+# Destroying a tenant deletes all of its vectors.
+resource "pinecone_namespace" "tenant" {
+  for_each = toset(["acme", "globex"])
+
+  index_name = pinecone_index.tenants.name
+  namespace  = each.key
+}
@@ -0,0 +1 @@
+terraform import pinecone_project.search 2c9a7e8f-3b1d-4c7e-9a55-0d4f6b2e1a77
@@ -0,0 +1,6 @@
+# One project per product team, each with its own pod quota.
+resource "pinecone_project" "search" {
+  name                       = "search"
+  max_pods                   = 10
+  force_encryption_with_cmek = true
+}
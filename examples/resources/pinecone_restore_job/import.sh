@@ -0,0 +1 @@
+terraform import pinecone_restore_job.movies_staging 4d5e6f70-8a9b-4c1d-b2e3-f4a5b6c7d8e9
@@ -0,0 +1,13 @@
+resource "pinecone_backup" "movies" {
+  source_index_name = "movies"
+}
+
+# Restore the backup into a staging index to try the migration on.
+resource "pinecone_restore_job" "movies_staging" {
+  backup_id  = pinecone_backup.movies.id
+  index_name = "movies-staging"
+}
+
+data "pinecone_index_stats" "movies_staging" {
+  index_name = pinecone_restore_job.movies_staging.index_name
+}
@@ -0,0 +1,12 @@
+resource "pinecone_index" "demo" {
+  name      = "demo"
+  dimension = 3
+}
+
+# fixtures.jsonl holds one vector per line:
+# {"id": "doc-1", "values": [0.1, 0.2, 0.3], "metadata": {"genre": "drama"}}
+resource "pinecone_vectors" "fixtures" {
+  index_name = pinecone_index.demo.name
+  namespace  = "fixtures"
+  source     = "${path.module}/fixtures.jsonl"
+}
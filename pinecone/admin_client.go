@@ -0,0 +1,277 @@
+package pinecone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultAdminBaseURL is the base URL of the Pinecone admin API, which manages
+// projects and API keys of an organization rather than indexes of a project.
+const DefaultAdminBaseURL = "https://api.pinecone.io/admin"
+
+// adminAPIVersion is the admin API version this client speaks.
+const adminAPIVersion = "2025-04"
+
+var (
+	_ AdminClientInterface = &AdminClient{}
+)
+
+// AdminClientInterface manages the projects of an organization and their API keys.
+type AdminClientInterface interface {
+	ListProjects(ctx context.Context) ([]Project, error)
+	CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error)
+	// DescribeProject returns nil if the project does not exist.
+	DescribeProject(ctx context.Context, projectID string) (*Project, error)
+	UpdateProject(ctx context.Context, projectID string, req UpdateProjectRequest) (*Project, error)
+	DeleteProject(ctx context.Context, projectID string) error
+	ListAPIKeys(ctx context.Context, projectID string) ([]APIKey, error)
+	// CreateAPIKey returns the secret value of the key, which is never returned again.
+	CreateAPIKey(ctx context.Context, projectID string, req CreateAPIKeyRequest) (*APIKeyWithSecret, error)
+	// DescribeAPIKey returns nil if the API key does not exist.
+	DescribeAPIKey(ctx context.Context, apiKeyID string) (*APIKey, error)
+	UpdateAPIKey(ctx context.Context, apiKeyID string, req UpdateAPIKeyRequest) (*APIKey, error)
+	DeleteAPIKey(ctx context.Context, apiKeyID string) error
+}
+
+type Project struct {
+	ID                      string `json:"id"`
+	Name                    string `json:"name"`
+	MaxPods                 int    `json:"max_pods"`
+	ForceEncryptionWithCMEK bool   `json:"force_encryption_with_cmek"`
+	OrganizationID          string `json:"organization_id"`
+	CreatedAt               string `json:"created_at"`
+}
+
+type CreateProjectRequest struct {
+	Name                    string `json:"name"`
+	MaxPods                 *int   `json:"max_pods,omitempty"`
+	ForceEncryptionWithCMEK bool   `json:"force_encryption_with_cmek,omitempty"`
+}
+
+// UpdateProjectRequest changes only the fields that are set.
+type UpdateProjectRequest struct {
+	Name                    *string `json:"name,omitempty"`
+	MaxPods                 *int    `json:"max_pods,omitempty"`
+	ForceEncryptionWithCMEK *bool   `json:"force_encryption_with_cmek,omitempty"`
+}
+
+// APIKeyRoles are the roles an API key may have.
+var APIKeyRoles = []string{
+	"ProjectEditor",
+	"ProjectViewer",
+	"ControlPlaneEditor",
+	"ControlPlaneViewer",
+	"DataPlaneEditor",
+	"DataPlaneViewer",
+}
+
+type APIKey struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	ProjectID string   `json:"project_id"`
+	Roles     []string `json:"roles"`
+}
+
+type APIKeyWithSecret struct {
+	Key   APIKey `json:"key"`
+	Value string `json:"value"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+// UpdateAPIKeyRequest changes only the fields that are set.
+type UpdateAPIKeyRequest struct {
+	Name  *string  `json:"name,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+type listProjectsResponse struct {
+	Data []Project `json:"data"`
+}
+
+type listAPIKeysResponse struct {
+	Data []APIKey `json:"data"`
+}
+
+// AdminClient talks to the admin API with the same credentials as PineconeClient.
+type AdminClient struct {
+	APIKey string
+	// Tokens, if set, authenticates requests with OAuth bearer tokens instead of APIKey.
+	Tokens *TokenSource
+	// BaseURL defaults to DefaultAdminBaseURL.
+	BaseURL string
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+func NewAdminClient(apiKey string) *AdminClient {
+	return &AdminClient{
+		APIKey:  apiKey,
+		BaseURL: DefaultAdminBaseURL,
+	}
+}
+
+// NewAdminClientWithTokens creates an admin client that authenticates with bearer tokens from tokens.
+func NewAdminClientWithTokens(tokens *TokenSource) *AdminClient {
+	return &AdminClient{
+		Tokens:  tokens,
+		BaseURL: DefaultAdminBaseURL,
+	}
+}
+
+func (c *AdminClient) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+// do sends a request to the admin API and decodes the JSON response into resp, if not nil.
+// It returns the status code so callers can tell a missing resource from a failure.
+func (c *AdminClient) do(ctx context.Context, method string, path string, body any, resp any) (int, error) {
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultAdminBaseURL
+	}
+
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, baseURL+path, payload)
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Add("accept", "application/json")
+	if body != nil {
+		httpReq.Header.Add("content-type", "application/json")
+	}
+	if err := setAuthHeader(ctx, httpReq, c.APIKey, c.Tokens); err != nil {
+		return 0, err
+	}
+	httpReq.Header.Add("X-Pinecone-Api-Version", adminAPIVersion)
+
+	res, err := c.httpClient().Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return res.StatusCode, err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		return res.StatusCode, fmt.Errorf("error: admin API %s %s status code: %d: %s", method, path, res.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+
+	if resp == nil || len(resBody) == 0 {
+		return res.StatusCode, nil
+	}
+	return res.StatusCode, json.Unmarshal(resBody, resp)
+}
+
+// ListProjects lists the projects of the organization
+func (c *AdminClient) ListProjects(ctx context.Context) ([]Project, error) {
+	var resp listProjectsResponse
+	if _, err := c.do(ctx, "GET", "/projects", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// CreateProject creates a project
+func (c *AdminClient) CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error) {
+	var resp Project
+	if _, err := c.do(ctx, "POST", "/projects", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DescribeProject describes a project
+func (c *AdminClient) DescribeProject(ctx context.Context, projectID string) (*Project, error) {
+	var resp Project
+	status, err := c.do(ctx, "GET", "/projects/"+projectID, nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateProject updates a project
+func (c *AdminClient) UpdateProject(ctx context.Context, projectID string, req UpdateProjectRequest) (*Project, error) {
+	var resp Project
+	if _, err := c.do(ctx, "PATCH", "/projects/"+projectID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteProject deletes a project
+func (c *AdminClient) DeleteProject(ctx context.Context, projectID string) error {
+	_, err := c.do(ctx, "DELETE", "/projects/"+projectID, nil, nil)
+	return err
+}
+
+// ListAPIKeys lists the API keys of a project
+func (c *AdminClient) ListAPIKeys(ctx context.Context, projectID string) ([]APIKey, error) {
+	var resp listAPIKeysResponse
+	if _, err := c.do(ctx, "GET", "/projects/"+projectID+"/api-keys", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// CreateAPIKey creates an API key in a project
+func (c *AdminClient) CreateAPIKey(ctx context.Context, projectID string, req CreateAPIKeyRequest) (*APIKeyWithSecret, error) {
+	var resp APIKeyWithSecret
+	if _, err := c.do(ctx, "POST", "/projects/"+projectID+"/api-keys", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DescribeAPIKey describes an API key
+func (c *AdminClient) DescribeAPIKey(ctx context.Context, apiKeyID string) (*APIKey, error) {
+	var resp APIKey
+	status, err := c.do(ctx, "GET", "/api-keys/"+apiKeyID, nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateAPIKey updates an API key
+func (c *AdminClient) UpdateAPIKey(ctx context.Context, apiKeyID string, req UpdateAPIKeyRequest) (*APIKey, error) {
+	var resp APIKey
+	if _, err := c.do(ctx, "PATCH", "/api-keys/"+apiKeyID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteAPIKey deletes an API key
+func (c *AdminClient) DeleteAPIKey(ctx context.Context, apiKeyID string) error {
+	_, err := c.do(ctx, "DELETE", "/api-keys/"+apiKeyID, nil, nil)
+	return err
+}
@@ -0,0 +1,170 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestAdminClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "test_api_key" || r.Header.Get("X-Pinecone-Api-Version") != adminAPIVersion {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /admin/projects":
+			_, _ = w.Write([]byte(`{"data":[{"id":"p1","name":"search","max_pods":5}]}`))
+		case "POST /admin/projects":
+			var req CreateProjectRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_ = json.NewEncoder(w).Encode(Project{ID: "p2", Name: req.Name, MaxPods: *req.MaxPods, OrganizationID: "org"})
+		case "GET /admin/projects/p2":
+			_, _ = w.Write([]byte(`{"id":"p2","name":"search","max_pods":10,"force_encryption_with_cmek":true}`))
+		case "PATCH /admin/projects/p2":
+			var req map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if !reflect.DeepEqual(req, map[string]any{"max_pods": float64(20)}) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"p2","name":"search","max_pods":20}`))
+		case "DELETE /admin/projects/p2":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NOT_FOUND"}}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cli := NewAdminClient("test_api_key")
+	cli.BaseURL = server.URL + "/admin"
+	cli.HTTPClient = server.Client()
+
+	projects, err := cli.ListProjects(ctx)
+	if err != nil || len(projects) != 1 || projects[0].MaxPods != 5 {
+		t.Fatalf("unexpected projects: %+v, %v", projects, err)
+	}
+
+	maxPods := 10
+	created, err := cli.CreateProject(ctx, CreateProjectRequest{Name: "search", MaxPods: &maxPods})
+	if err != nil || created.ID != "p2" || created.MaxPods != 10 || created.OrganizationID != "org" {
+		t.Fatalf("unexpected created project: %+v, %v", created, err)
+	}
+
+	described, err := cli.DescribeProject(ctx, "p2")
+	if err != nil || !described.ForceEncryptionWithCMEK {
+		t.Fatalf("unexpected described project: %+v, %v", described, err)
+	}
+	missing, err := cli.DescribeProject(ctx, "missing")
+	if err != nil || missing != nil {
+		t.Fatalf("expected no project and no error, but received %+v, %v", missing, err)
+	}
+
+	maxPods = 20
+	updated, err := cli.UpdateProject(ctx, "p2", UpdateProjectRequest{MaxPods: &maxPods})
+	if err != nil || updated.MaxPods != 20 {
+		t.Fatalf("unexpected updated project: %+v, %v", updated, err)
+	}
+
+	if err := cli.DeleteProject(ctx, "p2"); err != nil {
+		t.Fatal(err)
+	}
+	err = cli.DeleteProject(ctx, "missing")
+	if err == nil || err.Error() != `error: admin API DELETE /projects/missing status code: 404: {"error":{"code":"NOT_FOUND"}}` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"GET /admin/projects",
+		"POST /admin/projects",
+		"GET /admin/projects/p2",
+		"GET /admin/projects/missing",
+		"PATCH /admin/projects/p2",
+		"DELETE /admin/projects/p2",
+		"DELETE /admin/projects/missing",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, but received %v", expected, requests)
+	}
+}
+
+func TestAdminClientAPIKeys(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /projects/p1/api-keys":
+			_, _ = w.Write([]byte(`{"data":[{"id":"k1","name":"ci","project_id":"p1","roles":["ProjectViewer"]}]}`))
+		case "POST /projects/p1/api-keys":
+			var req CreateAPIKeyRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_ = json.NewEncoder(w).Encode(APIKeyWithSecret{
+				Key:   APIKey{ID: "k2", Name: req.Name, ProjectID: "p1", Roles: req.Roles},
+				Value: "pcsk_secret",
+			})
+		case "GET /api-keys/k2":
+			_, _ = w.Write([]byte(`{"id":"k2","name":"app","project_id":"p1","roles":["DataPlaneEditor"]}`))
+		case "PATCH /api-keys/k2":
+			_, _ = w.Write([]byte(`{"id":"k2","name":"app-v2","project_id":"p1","roles":["DataPlaneEditor"]}`))
+		case "DELETE /api-keys/k2":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cli := &AdminClient{APIKey: "test_api_key", BaseURL: server.URL, HTTPClient: server.Client()}
+
+	apiKeys, err := cli.ListAPIKeys(ctx, "p1")
+	if err != nil || len(apiKeys) != 1 || apiKeys[0].Roles[0] != "ProjectViewer" {
+		t.Fatalf("unexpected API keys: %+v, %v", apiKeys, err)
+	}
+
+	created, err := cli.CreateAPIKey(ctx, "p1", CreateAPIKeyRequest{Name: "app", Roles: []string{"DataPlaneEditor"}})
+	if err != nil || created.Key.ID != "k2" || created.Value != "pcsk_secret" {
+		t.Fatalf("unexpected created API key: %+v, %v", created, err)
+	}
+
+	described, err := cli.DescribeAPIKey(ctx, "k2")
+	if err != nil || described.Name != "app" {
+		t.Fatalf("unexpected described API key: %+v, %v", described, err)
+	}
+	missing, err := cli.DescribeAPIKey(ctx, "missing")
+	if err != nil || missing != nil {
+		t.Fatalf("expected no API key and no error, but received %+v, %v", missing, err)
+	}
+
+	name := "app-v2"
+	updated, err := cli.UpdateAPIKey(ctx, "k2", UpdateAPIKeyRequest{Name: &name})
+	if err != nil || updated.Name != "app-v2" {
+		t.Fatalf("unexpected updated API key: %+v, %v", updated, err)
+	}
+
+	if err := cli.DeleteAPIKey(ctx, "k2"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"GET /projects/p1/api-keys",
+		"POST /projects/p1/api-keys",
+		"GET /api-keys/k2",
+		"GET /api-keys/missing",
+		"PATCH /api-keys/k2",
+		"DELETE /api-keys/k2",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, but received %v", expected, requests)
+	}
+}
@@ -0,0 +1,175 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiKeyIDPrivateKey is the private data key holding the ID of an ephemeral API key until it is closed.
+const apiKeyIDPrivateKey = "api_key_id"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &apiKeyEphemeralResource{}
+)
+
+// NewAPIKeyEphemeralResource is a helper function to simplify the provider implementation.
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+// apiKeyEphemeralResource is the ephemeral resource implementation.
+type apiKeyEphemeralResource struct {
+	client AdminClientInterface
+}
+
+type apiKeyEphemeralResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	Roles     types.Set    `tfsdk:"roles"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *apiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *apiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a short-lived API key of a project that is never stored in state. Terraform creates the key " +
+			"when it needs the value and deletes it when done, in each plan and apply. Configure another pinecone " +
+			"provider's api_key with the value to run an apply with a key that only exists during it. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the API key.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project of the API key.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the API key.",
+				Required:    true,
+			},
+			"roles": schema.SetAttribute{
+				Description: fmt.Sprintf("The roles of the API key, any of %s. Defaults to [\"%s\"].",
+					strings.Join(APIKeyRoles, ", "), defaultAPIKeyRole),
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"value": schema.StringAttribute{
+				Description: "The secret value of the API key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that every role is known.
+func (r *apiKeyEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var roles types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("roles"), &roles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateAPIKeyRoles(roles); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("roles"), "Invalid role", err.Error())
+	}
+}
+
+// Open creates the API key.
+func (r *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles := []string{defaultAPIKeyRole}
+	if !data.Roles.IsNull() {
+		resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	created, err := r.client.CreateAPIKey(ctx, data.ProjectID.ValueString(), CreateAPIKeyRequest{
+		Name:  data.Name.ValueString(),
+		Roles: roles,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			"Could not create API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remember the key so Close can delete it. Private data must be JSON.
+	apiKeyID, err := json.Marshal(created.Key.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyIDPrivateKey, apiKeyID)...)
+
+	model := apiKeyResourceModel{}
+	model.setAPIKey(&created.Key)
+	data.ID = model.ID
+	data.Roles = model.Roles
+	data.Value = types.StringValue(created.Value)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the API key created by Open.
+func (r *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, apiKeyIDPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	var apiKeyID string
+	if err := json.Unmarshal(data, &apiKeyID); err != nil {
+		resp.Diagnostics.AddError("Error deleting API key", "Invalid private data: "+err.Error())
+		return
+	}
+	if err := r.client.DeleteAPIKey(ctx, apiKeyID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting API key",
+			"Could not delete API key "+apiKeyID+", unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *apiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.adminClient
+}
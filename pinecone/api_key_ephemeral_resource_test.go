@@ -0,0 +1,194 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// newDynamicValue encodes an object of typ with the given attributes, the rest null.
+func newDynamicValue(t *testing.T, typ tftypes.Type, attributes map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	values := make(map[string]tftypes.Value)
+	for name, attributeType := range typ.(tftypes.Object).AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	value, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &value
+}
+
+// TestAPIKeyEphemeralResource opens and closes the ephemeral resource through the
+// provider protocol, as Terraform 1.10 would.
+func TestAPIKeyEphemeralResource(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := NewMockAdminClient()
+	project, err := admin.CreateProject(ctx, CreateProjectRequest{Name: "search"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := providerserver.NewProtocol6(&pineconeProvider{client: cli, adminClient: admin})()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ephemeralSchema, ok := schemas.EphemeralResourceSchemas["pinecone_api_key"]
+	if !ok {
+		t.Fatal("expected the pinecone_api_key ephemeral resource")
+	}
+
+	configured, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: newDynamicValue(t, schemas.Provider.ValueType(), map[string]tftypes.Value{
+			"environment": tftypes.NewValue(tftypes.String, "test"),
+			"api_key":     tftypes.NewValue(tftypes.String, "test_api_key"),
+		}),
+	})
+	if err != nil || len(configured.Diagnostics) > 0 {
+		t.Fatalf("unexpected configure result: %+v, %v", configured, err)
+	}
+
+	typ := ephemeralSchema.ValueType()
+	opened, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "pinecone_api_key",
+		Config: newDynamicValue(t, typ, map[string]tftypes.Value{
+			"project_id": tftypes.NewValue(tftypes.String, project.ID),
+			"name":       tftypes.NewValue(tftypes.String, "apply"),
+		}),
+	})
+	if err != nil || len(opened.Diagnostics) > 0 {
+		t.Fatalf("unexpected open result: %+v, %v", opened, err)
+	}
+
+	result, err := opened.Result.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := result.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	var value string
+	if err := attributes["value"].As(&value); err != nil || value != "pcsk_mock_2" {
+		t.Fatalf("expected the value pcsk_mock_2, but received %q, %v", value, err)
+	}
+	var roles []tftypes.Value
+	if err := attributes["roles"].As(&roles); err != nil || len(roles) != 1 {
+		t.Fatalf("expected the default role, but received %v, %v", roles, err)
+	}
+
+	apiKeys, _ := admin.ListAPIKeys(ctx, project.ID)
+	if len(apiKeys) != 1 {
+		t.Fatalf("expected 1 API key while open, but found %+v", apiKeys)
+	}
+
+	closed, err := server.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "pinecone_api_key",
+		Private:  opened.Private,
+	})
+	if err != nil || len(closed.Diagnostics) > 0 {
+		t.Fatalf("unexpected close result: %+v, %v", closed, err)
+	}
+
+	apiKeys, _ = admin.ListAPIKeys(ctx, project.ID)
+	if len(apiKeys) != 0 {
+		t.Fatalf("expected no API keys after close, but found %+v", apiKeys)
+	}
+}
+
+func TestAccAPIKeyEphemeralResource(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := NewMockAdminClient()
+	project, err := admin.CreateProject(ctx, CreateProjectRequest{Name: "search"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	factories := testAccProtoV6ProviderFactoriesWithAdminClient(cli, admin)
+	factories["echo"] = echoprovider.NewProviderServer()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: factories,
+		CheckDestroy: func(_ *terraform.State) error {
+			apiKeys, err := admin.ListAPIKeys(ctx, project.ID)
+			if err != nil {
+				return err
+			}
+			if len(apiKeys) != 0 {
+				return fmt.Errorf("expected every ephemeral API key to be deleted, but found %+v", apiKeys)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+ephemeral "pinecone_api_key" "test" {
+    project_id = %q
+    name       = "apply"
+    roles      = ["DataPlaneEditor"]
+}
+
+provider "echo" {
+    data = ephemeral.pinecone_api_key.test
+}
+
+resource "echo" "test" {}
+`, project.ID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("apply")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("value"), knownvalue.StringRegexp(regexp.MustCompile(`^pcsk_mock_`))),
+				},
+			},
+			// An aliased provider authenticates with the ephemeral API key.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+ephemeral "pinecone_api_key" "scoped" {
+    project_id = %q
+    name       = "scoped"
+    roles      = ["ProjectViewer"]
+}
+
+provider "pinecone" {
+    alias       = "scoped"
+    environment = "test"
+    api_key     = ephemeral.pinecone_api_key.scoped.value
+}
+
+data "pinecone_backups" "scoped" {
+    provider = pinecone.scoped
+}
+`, project.ID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pinecone_backups.scoped", tfjsonpath.New("backups"), knownvalue.ListExact([]knownvalue.Check{})),
+				},
+			},
+		},
+	})
+}
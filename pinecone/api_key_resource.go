@@ -0,0 +1,312 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultAPIKeyRole is the role of an API key without roles.
+const defaultAPIKeyRole = "ProjectEditor"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &apiKeyResource{}
+	_ resource.ResourceWithConfigure      = &apiKeyResource{}
+	_ resource.ResourceWithImportState    = &apiKeyResource{}
+	_ resource.ResourceWithValidateConfig = &apiKeyResource{}
+)
+
+// NewAPIKeyResource is a helper function to simplify the provider implementation.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource is the resource implementation.
+type apiKeyResource struct {
+	client AdminClientInterface
+}
+
+type apiKeyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	Name             types.String `tfsdk:"name"`
+	Roles            types.Set    `tfsdk:"roles"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	Value            types.String `tfsdk:"value"`
+}
+
+// setAPIKey sets the model from an API key returned by the admin API. The value is left alone.
+func (m *apiKeyResourceModel) setAPIKey(apiKey *APIKey) {
+	roles := make([]attr.Value, len(apiKey.Roles))
+	for i, role := range apiKey.Roles {
+		roles[i] = types.StringValue(role)
+	}
+
+	m.ID = types.StringValue(apiKey.ID)
+	m.ProjectID = types.StringValue(apiKey.ProjectID)
+	m.Name = types.StringValue(apiKey.Name)
+	m.Roles = types.SetValueMust(types.StringType, roles)
+}
+
+// Metadata returns the resource type name.
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an API key of a project through the admin API. Pinecone returns the secret value only when " +
+			"the key is created, so it is unknown after import. Change rotation_triggers to rotate the key, " +
+			"with lifecycle { create_before_destroy = true } so the old key keeps working until dependents use the new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project of the API key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the API key.",
+				Required:    true,
+			},
+			"roles": schema.SetAttribute{
+				Description: fmt.Sprintf("The roles of the API key, any of %s. Defaults to [\"%s\"].",
+					strings.Join(APIKeyRoles, ", "), defaultAPIKeyRole),
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue(defaultAPIKeyRole),
+				})),
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary values that replace the API key with a new one when changed, e.g. { rotated = \"2026-01\" }.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The secret value of the API key. Only known for keys created by Terraform.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that every role is known.
+func (r *apiKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var roles types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("roles"), &roles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateAPIKeyRoles(roles); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("roles"), "Invalid role", err.Error())
+	}
+}
+
+// validateAPIKeyRoles returns an error for the first role that is not one of APIKeyRoles.
+// Unknown roles are checked once they are known.
+func validateAPIKeyRoles(roles types.Set) error {
+	if roles.IsNull() || roles.IsUnknown() {
+		return nil
+	}
+
+	for _, role := range roles.Elements() {
+		value, ok := role.(types.String)
+		if !ok || value.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(APIKeyRoles, value.ValueString()) {
+			return fmt.Errorf("role must be one of %s, got %q.", strings.Join(APIKeyRoles, ", "), value.ValueString())
+		}
+	}
+	return nil
+}
+
+// apiKeyRoles returns the roles of the model, sorted.
+func apiKeyRoles(ctx context.Context, model apiKeyResourceModel) ([]string, error) {
+	var roles []string
+	if diags := model.Roles.ElementsAs(ctx, &roles, false); diags.HasError() {
+		return nil, fmt.Errorf("error: invalid roles")
+	}
+	sort.Strings(roles)
+	return roles, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := apiKeyRoles(ctx, plan)
+	if err == nil {
+		var created *APIKeyWithSecret
+		created, err = r.client.CreateAPIKey(ctx, plan.ProjectID.ValueString(), CreateAPIKeyRequest{
+			Name:  plan.Name.ValueString(),
+			Roles: roles,
+		})
+		if err == nil {
+			plan.setAPIKey(&created.Key)
+			plan.Value = types.StringValue(created.Value)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			"Could not create API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.client.DescribeAPIKey(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone API Key",
+			"Could not read Pinecone API Key ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// If the API key is not found, remove it from the state
+	if apiKey == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.setAPIKey(apiKey)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update renames the API key or changes its roles. The key and its value stay the same.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send what changed
+	var updateReq UpdateAPIKeyRequest
+	if !plan.Name.Equal(state.Name) {
+		updateReq.Name = plan.Name.ValueStringPointer()
+	}
+	roles, err := apiKeyRoles(ctx, plan)
+	if err == nil && !plan.Roles.Equal(state.Roles) {
+		updateReq.Roles = roles
+	}
+
+	var apiKey *APIKey
+	if err == nil {
+		apiKey, err = r.client.UpdateAPIKey(ctx, state.ID.ValueString(), updateReq)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating API key",
+			"Could not update API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.setAPIKey(apiKey)
+	// The value is null after import, which UseStateForUnknown leaves unknown.
+	plan.Value = state.Value
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteAPIKey(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting API key",
+			"Could not delete API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.adminClient
+}
+
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,115 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAPIKeyResource(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := NewMockAdminClient()
+	project, err := admin.CreateProject(ctx, CreateProjectRequest{Name: "search"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiKeyCount := func(expected int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			apiKeys, err := admin.ListAPIKeys(ctx, project.ID)
+			if err != nil {
+				return err
+			}
+			if len(apiKeys) != expected {
+				return fmt.Errorf("expected %d API keys, but found %+v", expected, apiKeys)
+			}
+			return nil
+		}
+	}
+	config := func(name string, roles string, rotation string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "pinecone_api_key" "test" {
+    project_id        = %q
+    name              = %q
+    roles             = %s
+    rotation_triggers = { rotated = %q }
+
+    lifecycle {
+        create_before_destroy = true
+    }
+}
+`, project.ID, name, roles, rotation)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithAdminClient(cli, admin),
+		CheckDestroy:             apiKeyCount(0),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("app", `["DataPlaneEditor", "ControlPlaneViewer"]`, "2026-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "id", "mock-api-key-2"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "project_id", project.ID),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("pinecone_api_key.test", "roles.*", "DataPlaneEditor"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "value", "pcsk_mock_2"),
+					apiKeyCount(1),
+				),
+			},
+			// ImportState testing; the value is only returned at create
+			{
+				ResourceName:            "pinecone_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value", "rotation_triggers"},
+			},
+			// Renaming and changing roles keep the key
+			{
+				Config: config("app-v2", `["DataPlaneViewer"]`, "2026-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "id", "mock-api-key-2"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "name", "app-v2"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "value", "pcsk_mock_2"),
+				),
+			},
+			// Changing rotation_triggers creates a new key before deleting the old one
+			{
+				Config: config("app-v2", `["DataPlaneViewer"]`, "2026-02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "id", "mock-api-key-3"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "value", "pcsk_mock_3"),
+					apiKeyCount(1),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAPIKeyResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pinecone_api_key" "test" {
+    project_id = "project"
+    name       = "app"
+    roles      = ["Admin"]
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)role\s+must\s+be\s+one\s+of\s+ProjectEditor,.*got\s+"Admin"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,250 @@
+package pinecone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// assistantPollInterval is how often assistants and their files are checked while waiting for them.
+var assistantPollInterval = 5 * time.Second
+
+const (
+	AssistantStatusReady  = "Ready"
+	AssistantStatusFailed = "Failed"
+
+	AssistantFileStatusAvailable        = "Available"
+	AssistantFileStatusProcessingFailed = "ProcessingFailed"
+)
+
+// Assistant answers questions about the files uploaded to it.
+type Assistant struct {
+	Name         string         `json:"name"`
+	Instructions string         `json:"instructions,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+	Status       string         `json:"status"`
+	Host         string         `json:"host,omitempty"`
+	CreatedAt    string         `json:"created_at,omitempty"`
+	UpdatedAt    string         `json:"updated_at,omitempty"`
+}
+
+type CreateAssistantRequest struct {
+	Name         string         `json:"name"`
+	Instructions string         `json:"instructions,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+}
+
+// UpdateAssistantRequest replaces the instructions and metadata of an assistant. Empty values clear them.
+type UpdateAssistantRequest struct {
+	Instructions string         `json:"instructions"`
+	Metadata     map[string]any `json:"metadata"`
+}
+
+// AssistantFile is a file uploaded to an assistant.
+type AssistantFile struct {
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+	Status       string         `json:"status"`
+	PercentDone  float64        `json:"percent_done"`
+	ErrorMessage string         `json:"error_message,omitempty"`
+	CreatedOn    string         `json:"created_on,omitempty"`
+	UpdatedOn    string         `json:"updated_on,omitempty"`
+}
+
+// CreateAssistant creates an assistant
+func (c *PineconeClient) CreateAssistant(ctx context.Context, req CreateAssistantRequest) (*Assistant, error) {
+	var resp Assistant
+	if _, err := c.do(ctx, "POST", "/assistant/assistants", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DescribeAssistant describes an assistant
+func (c *PineconeClient) DescribeAssistant(ctx context.Context, name string) (*Assistant, error) {
+	var resp Assistant
+	status, err := c.do(ctx, "GET", "/assistant/assistants/"+name, nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateAssistant changes the instructions and metadata of an assistant
+func (c *PineconeClient) UpdateAssistant(ctx context.Context, name string, req UpdateAssistantRequest) (*Assistant, error) {
+	var resp Assistant
+	if _, err := c.do(ctx, "PATCH", "/assistant/assistants/"+name, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteAssistant deletes an assistant and its files
+func (c *PineconeClient) DeleteAssistant(ctx context.Context, name string) error {
+	_, err := c.do(ctx, "DELETE", "/assistant/assistants/"+name, nil, nil)
+	return err
+}
+
+// assistantFilesURL returns the URL of the files of an assistant, on the host of the assistant.
+func (c *PineconeClient) assistantFilesURL(ctx context.Context, assistantName string) (string, error) {
+	assistant, err := c.DescribeAssistant(ctx, assistantName)
+	if err != nil {
+		return "", err
+	}
+	if assistant == nil {
+		return "", fmt.Errorf("error: assistant %s not found", assistantName)
+	}
+	if assistant.Host == "" {
+		return "", fmt.Errorf("error: assistant %s has no host yet, it may not be ready", assistantName)
+	}
+
+	host := assistant.Host
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	return host + "/assistant/files/" + assistantName, nil
+}
+
+// UploadAssistantFile uploads a file to an assistant, which then processes it in the background
+func (c *PineconeClient) UploadAssistantFile(ctx context.Context, assistantName string, fileName string, content io.Reader, metadata map[string]any) (*AssistantFile, error) {
+	filesURL, err := c.assistantFilesURL(ctx, assistantName)
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata) > 0 {
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			return nil, err
+		}
+		filesURL += "?" + url.Values{"metadata": {string(data)}}.Encode()
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	var resp AssistantFile
+	if _, err := c.send(ctx, "POST", filesURL, writer.FormDataContentType(), &body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DescribeAssistantFile describes a file of an assistant
+func (c *PineconeClient) DescribeAssistantFile(ctx context.Context, assistantName string, fileID string) (*AssistantFile, error) {
+	filesURL, err := c.assistantFilesURL(ctx, assistantName)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp AssistantFile
+	status, err := c.send(ctx, "GET", filesURL+"/"+fileID, "", nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteAssistantFile deletes a file of an assistant
+func (c *PineconeClient) DeleteAssistantFile(ctx context.Context, assistantName string, fileID string) error {
+	filesURL, err := c.assistantFilesURL(ctx, assistantName)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.send(ctx, "DELETE", filesURL+"/"+fileID, "", nil, nil)
+	return err
+}
+
+// WaitForAssistant polls an assistant until it is ready, and returns an error if it failed or disappeared.
+func WaitForAssistant(ctx context.Context, client PineconeClientInterface, name string) (*Assistant, error) {
+	for {
+		assistant, err := client.DescribeAssistant(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if assistant == nil {
+			return nil, fmt.Errorf("error: assistant %s not found", name)
+		}
+		switch assistant.Status {
+		case AssistantStatusReady:
+			return assistant, nil
+		case AssistantStatusFailed:
+			return nil, fmt.Errorf("error: assistant %s failed", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(assistantPollInterval):
+		}
+	}
+}
+
+// WaitForAssistantDeleted polls an assistant until it no longer exists.
+func WaitForAssistantDeleted(ctx context.Context, client PineconeClientInterface, name string) error {
+	for {
+		assistant, err := client.DescribeAssistant(ctx, name)
+		if err != nil {
+			return err
+		}
+		if assistant == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(assistantPollInterval):
+		}
+	}
+}
+
+// WaitForAssistantFile polls a file of an assistant until it is processed, and returns
+// an error if processing failed or the file disappeared.
+func WaitForAssistantFile(ctx context.Context, client PineconeClientInterface, assistantName string, fileID string) (*AssistantFile, error) {
+	for {
+		file, err := client.DescribeAssistantFile(ctx, assistantName, fileID)
+		if err != nil {
+			return nil, err
+		}
+		if file == nil {
+			return nil, fmt.Errorf("error: file %s of assistant %s not found", fileID, assistantName)
+		}
+		switch file.Status {
+		case AssistantFileStatusAvailable:
+			return file, nil
+		case AssistantFileStatusProcessingFailed:
+			return nil, fmt.Errorf("error: assistant %s failed to process file %s: %s", assistantName, file.Name, file.ErrorMessage)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(assistantPollInterval):
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package pinecone
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &assistantFileResource{}
+	_ resource.ResourceWithConfigure  = &assistantFileResource{}
+	_ resource.ResourceWithModifyPlan = &assistantFileResource{}
+)
+
+// NewAssistantFileResource is a helper function to simplify the provider implementation.
+func NewAssistantFileResource() resource.Resource {
+	return &assistantFileResource{}
+}
+
+// assistantFileResource is the resource implementation.
+type assistantFileResource struct {
+	client PineconeClientInterface
+}
+
+type assistantFileResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AssistantName types.String `tfsdk:"assistant_name"`
+	Source        types.String `tfsdk:"source"`
+	Metadata      types.Map    `tfsdk:"metadata"`
+	ContentHash   types.String `tfsdk:"content_hash"`
+	Name          types.String `tfsdk:"name"`
+	Status        types.String `tfsdk:"status"`
+	CreatedOn     types.String `tfsdk:"created_on"`
+}
+
+// setAssistantFile sets the model from a file returned by Pinecone.
+func (m *assistantFileResourceModel) setAssistantFile(file *AssistantFile) {
+	m.ID = types.StringValue(file.ID)
+	m.Name = types.StringValue(file.Name)
+	m.Status = types.StringValue(file.Status)
+	m.CreatedOn = types.StringValue(file.CreatedOn)
+}
+
+// Metadata returns the resource type name.
+func (r *assistantFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assistant_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *assistantFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Upload a local file to a Pinecone Assistant. Terraform waits until the assistant processed the file, " +
+			"and uploads it again when its content changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the uploaded file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"assistant_name": schema.StringAttribute{
+				Description: "The name of the assistant.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of the file to upload, e.g. a PDF, text, markdown or DOCX file.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Metadata of the file, which chats can filter on.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "The SHA-256 of the file content. A new hash uploads the file again.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the uploaded file, the base name of source.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the file, e.g. Available.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "When the file was uploaded.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan hashes the source file, and replaces the uploaded file when its content changed.
+func (r *assistantFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to read when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan assistantFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The file is read on the next plan when its path is known only after apply.
+	if plan.Source.IsUnknown() {
+		return
+	}
+
+	checksum, err := fileSHA256(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid source", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringValue(checksum))...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state assistantFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ContentHash.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+}
+
+// Create uploads the file and waits until the assistant processed it.
+func (r *assistantFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan assistantFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source := plan.Source.ValueString()
+	content, err := os.ReadFile(source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid source", err.Error())
+		return
+	}
+	checksum := sha256.Sum256(content)
+	plan.ContentHash = types.StringValue(hex.EncodeToString(checksum[:]))
+
+	var file *AssistantFile
+	metadata, err := newAnyMap(ctx, plan.Metadata)
+	if err == nil {
+		file, err = r.client.UploadAssistantFile(ctx, plan.AssistantName.ValueString(), filepath.Base(source), bytes.NewReader(content), metadata)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error uploading assistant file",
+			"Could not upload "+source+" to assistant "+plan.AssistantName.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save the file before waiting, so that it is not orphaned when processing fails.
+	// Terraform then taints it, and replacing it deletes the file.
+	plan.setAssistantFile(file)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err = WaitForAssistantFile(ctx, r.client, plan.AssistantName.ValueString(), file.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error uploading assistant file",
+			"File "+plan.ID.ValueString()+" of assistant "+plan.AssistantName.ValueString()+" was not processed: "+err.Error(),
+		)
+		return
+	}
+	plan.setAssistantFile(file)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *assistantFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state assistantFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The files of a deleted assistant are gone with it.
+	assistantName := state.AssistantName.ValueString()
+	assistant, err := r.client.DescribeAssistant(ctx, assistantName)
+	var file *AssistantFile
+	if err == nil && assistant != nil {
+		file, err = r.client.DescribeAssistantFile(ctx, assistantName, state.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Assistant File",
+			"Could not read Pinecone Assistant File ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// If the file is not found, remove it from the state
+	if file == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.setAssistantFile(file)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called with changes, as every argument replaces the file.
+func (r *assistantFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan assistantFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the file from the assistant, unless the assistant was deleted with it.
+func (r *assistantFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state assistantFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assistantName := state.AssistantName.ValueString()
+	assistant, err := r.client.DescribeAssistant(ctx, assistantName)
+	if err == nil && assistant != nil {
+		var file *AssistantFile
+		file, err = r.client.DescribeAssistantFile(ctx, assistantName, state.ID.ValueString())
+		if err == nil && file != nil {
+			err = r.client.DeleteAssistantFile(ctx, assistantName, state.ID.ValueString())
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting assistant file",
+			"Could not delete file "+state.ID.ValueString()+" of assistant "+assistantName+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *assistantFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+}
@@ -0,0 +1,207 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssistantFileResource(t *testing.T) {
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := filepath.Join(t.TempDir(), "guide.md")
+	if err := os.WriteFile(source, []byte("# Guide\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := providerConfig + fmt.Sprintf(`
+resource "pinecone_assistant" "test" {
+	name = "docs"
+}
+
+resource "pinecone_assistant_file" "test" {
+	assistant_name = pinecone_assistant.test.name
+	source         = %q
+	metadata = {
+		kind = "guide"
+	}
+}
+`, source)
+	checkContent := func(content string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			contents := cli.AssistantFileContents("docs")
+			if len(contents) != 1 || contents["guide.md"] != content {
+				return fmt.Errorf("expected only guide.md with %q, got %v", content, contents)
+			}
+			return nil
+		}
+	}
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_assistant_file.test", "name", "guide.md"),
+					resource.TestCheckResourceAttr("pinecone_assistant_file.test", "status", "Available"),
+					resource.TestCheckResourceAttr("pinecone_assistant_file.test", "metadata.kind", "guide"),
+					resource.TestCheckResourceAttr("pinecone_assistant_file.test", "content_hash", "bc553ffe57e544498b12a9865dbf3abc2004c474e349c52c378eaa402287424b"),
+					resource.TestCheckResourceAttrWith("pinecone_assistant_file.test", "id", func(id string) error {
+						firstID = id
+						return nil
+					}),
+					checkContent("# Guide\n"),
+				),
+			},
+			// An unchanged file is not uploaded again.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			// A changed file is uploaded again.
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte("# Guide\n\nUpdated.\n"), 0o644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_assistant_file.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("pinecone_assistant.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("pinecone_assistant_file.test", "id", func(id string) error {
+						if id == firstID {
+							return fmt.Errorf("expected a new file, got %s again", id)
+						}
+						return nil
+					}),
+					checkContent("# Guide\n\nUpdated.\n"),
+				),
+			},
+			// A file deleted outside of Terraform is uploaded again.
+			{
+				PreConfig: func() {
+					for _, file := range cli.assistantFiles["docs"] {
+						_ = cli.DeleteAssistantFile(context.Background(), "docs", file.ID)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_assistant_file.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: checkContent("# Guide\n\nUpdated.\n"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAssistantFileResourceMissingSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "missing.md")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "pinecone_assistant_file" "test" {
+	assistant_name = "docs"
+	source         = %q
+}
+`, source),
+				ExpectError: regexp.MustCompile(`(?s)Invalid source.*` + regexp.QuoteMeta(filepath.Base(source))),
+			},
+		},
+	})
+}
+
+// failedAssistantFileClient fails to process every file while failed is set.
+type failedAssistantFileClient struct {
+	*MockPineconeClient
+	failed bool
+}
+
+func (c *failedAssistantFileClient) DescribeAssistantFile(ctx context.Context, assistantName string, fileID string) (*AssistantFile, error) {
+	file, err := c.MockPineconeClient.DescribeAssistantFile(ctx, assistantName, fileID)
+	if err != nil || file == nil || !c.failed {
+		return file, err
+	}
+	file.Status = AssistantFileStatusProcessingFailed
+	file.ErrorMessage = "no text found"
+	return file, nil
+}
+
+func TestAccAssistantFileResourceProcessingFailed(t *testing.T) {
+	defer func(interval time.Duration) { assistantPollInterval = interval }(assistantPollInterval)
+	assistantPollInterval = time.Millisecond
+
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mock.CreateAssistant(context.Background(), CreateAssistantRequest{Name: "docs"}); err != nil {
+		t.Fatal(err)
+	}
+	cli := &failedAssistantFileClient{MockPineconeClient: mock, failed: true}
+	source := filepath.Join(t.TempDir(), "scan.md")
+	if err := os.WriteFile(source, []byte("# Scan\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	config := providerConfig + fmt.Sprintf(`
+resource "pinecone_assistant_file" "test" {
+	assistant_name = "docs"
+	source         = %q
+}
+`, source)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// A file that fails to process is kept in the state
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s)was\s+not\s+processed.*no\s+text\s+found`),
+			},
+			// and replaced by the next apply, which deletes it.
+			{
+				PreConfig: func() {
+					cli.failed = false
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_assistant_file.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_assistant_file.test", "status", "Available"),
+					func(s *terraform.State) error {
+						if files := mock.assistantFiles["docs"]; len(files) != 1 {
+							return fmt.Errorf("expected the failed file to be deleted, but found %d files", len(files))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,291 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &assistantResource{}
+	_ resource.ResourceWithConfigure   = &assistantResource{}
+	_ resource.ResourceWithImportState = &assistantResource{}
+)
+
+// NewAssistantResource is a helper function to simplify the provider implementation.
+func NewAssistantResource() resource.Resource {
+	return &assistantResource{}
+}
+
+// assistantResource is the resource implementation.
+type assistantResource struct {
+	client PineconeClientInterface
+}
+
+type assistantResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Instructions types.String `tfsdk:"instructions"`
+	Metadata     types.Map    `tfsdk:"metadata"`
+	Status       types.String `tfsdk:"status"`
+	Host         types.String `tfsdk:"host"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// setAssistant sets the model from an assistant returned by Pinecone. Empty instructions
+// or metadata stay null, so that leaving them out of the configuration is not drift.
+func (m *assistantResourceModel) setAssistant(assistant *Assistant) error {
+	m.ID = types.StringValue(assistant.Name)
+	m.Name = types.StringValue(assistant.Name)
+	if assistant.Instructions != "" || !m.Instructions.IsNull() {
+		m.Instructions = types.StringValue(assistant.Instructions)
+	}
+	metadata, err := newTFStringMap(assistant.Metadata)
+	if err != nil {
+		return err
+	}
+	if !metadata.IsNull() || m.Metadata.IsNull() {
+		m.Metadata = metadata
+	}
+	m.Status = types.StringValue(assistant.Status)
+	m.Host = types.StringValue(assistant.Host)
+	m.CreatedAt = types.StringValue(assistant.CreatedAt)
+	return nil
+}
+
+// Metadata returns the resource type name.
+func (r *assistantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assistant"
+}
+
+// Schema defines the schema for the resource.
+func (r *assistantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a Pinecone Assistant, which answers questions about the files uploaded to it with pinecone_assistant_file. " +
+			"Terraform waits until the assistant is ready. Destroying it deletes its files.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the assistant, its name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the assistant.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instructions": schema.StringAttribute{
+				Description: "Instructions the assistant follows in every answer, e.g. to answer in a given language or tone.",
+				Optional:    true,
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Metadata of the assistant. Values that are not strings in Pinecone are shown as JSON.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the assistant, e.g. Ready.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Description: "The host serving the files and chats of the assistant.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the assistant was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the assistant and waits until it is ready.
+func (r *assistantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan assistantResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assistant *Assistant
+	metadata, err := newAnyMap(ctx, plan.Metadata)
+	if err == nil {
+		assistant, err = r.client.CreateAssistant(ctx, CreateAssistantRequest{
+			Name:         plan.Name.ValueString(),
+			Instructions: plan.Instructions.ValueString(),
+			Metadata:     metadata,
+		})
+	}
+	if err == nil {
+		err = plan.setAssistant(assistant)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating assistant",
+			"Could not create assistant, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save the assistant before waiting, so that it is not orphaned when waiting fails.
+	// Terraform then taints it, and replacing it deletes the assistant.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assistant, err = WaitForAssistant(ctx, r.client, plan.Name.ValueString())
+	if err == nil {
+		err = plan.setAssistant(assistant)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating assistant",
+			"Assistant "+plan.Name.ValueString()+" did not become ready: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *assistantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state assistantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assistant, err := r.client.DescribeAssistant(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Assistant",
+			"Could not read Pinecone Assistant ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// If the assistant is not found, remove it from the state
+	if assistant == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err := state.setAssistant(assistant); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Assistant",
+			"Could not read Pinecone Assistant ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update changes the instructions and metadata of the assistant.
+func (r *assistantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state assistantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assistant *Assistant
+	metadata, err := newAnyMap(ctx, plan.Metadata)
+	if err == nil {
+		assistant, err = r.client.UpdateAssistant(ctx, state.ID.ValueString(), UpdateAssistantRequest{
+			Instructions: plan.Instructions.ValueString(),
+			Metadata:     metadata,
+		})
+	}
+	if err == nil {
+		err = plan.setAssistant(assistant)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating assistant",
+			"Could not update assistant, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the assistant and waits until it is gone.
+func (r *assistantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state assistantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAssistant(ctx, state.ID.ValueString())
+	if err == nil {
+		err = WaitForAssistantDeleted(ctx, r.client, state.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting assistant",
+			"Could not delete assistant, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *assistantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+}
+
+func (r *assistantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
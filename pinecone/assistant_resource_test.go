@@ -0,0 +1,157 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssistantResource(t *testing.T) {
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "pinecone_assistant" "test" {
+	name         = "docs"
+	instructions = "Answer from the internal docs only."
+	metadata = {
+		team = "search"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_assistant.test", "id", "docs"),
+					resource.TestCheckResourceAttr("pinecone_assistant.test", "name", "docs"),
+					resource.TestCheckResourceAttr("pinecone_assistant.test", "instructions", "Answer from the internal docs only."),
+					resource.TestCheckResourceAttr("pinecone_assistant.test", "metadata.team", "search"),
+					resource.TestCheckResourceAttr("pinecone_assistant.test", "status", "Ready"),
+					resource.TestCheckResourceAttr("pinecone_assistant.test", "host", "docs-mock.svc.test.pinecone.io"),
+					resource.TestCheckResourceAttrSet("pinecone_assistant.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pinecone_assistant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Instructions and metadata change in place, and removing them clears them.
+			{
+				Config: providerConfig + `
+resource "pinecone_assistant" "test" {
+	name         = "docs"
+	instructions = "Answer in French."
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_assistant.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_assistant.test", "instructions", "Answer in French."),
+					resource.TestCheckNoResourceAttr("pinecone_assistant.test", "metadata"),
+					func(s *terraform.State) error {
+						assistant, _ := cli.DescribeAssistant(context.Background(), "docs")
+						if assistant.Instructions != "Answer in French." || len(assistant.Metadata) != 0 {
+							return fmt.Errorf("assistant not updated: %+v", assistant)
+						}
+						return nil
+					},
+				),
+			},
+			// An assistant deleted outside of Terraform is created again.
+			{
+				PreConfig: func() {
+					_ = cli.DeleteAssistant(context.Background(), "docs")
+				},
+				Config: providerConfig + `
+resource "pinecone_assistant" "test" {
+	name         = "docs"
+	instructions = "Answer in French."
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_assistant.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if assistant, _ := cli.DescribeAssistant(context.Background(), "docs"); assistant != nil {
+				return fmt.Errorf("assistant docs still exists")
+			}
+			return nil
+		},
+	})
+}
+
+// failedAssistantClient reports every assistant with a status, e.g. Failed.
+type failedAssistantClient struct {
+	*MockPineconeClient
+	status string
+}
+
+func (c *failedAssistantClient) DescribeAssistant(ctx context.Context, name string) (*Assistant, error) {
+	assistant, err := c.MockPineconeClient.DescribeAssistant(ctx, name)
+	if err != nil || assistant == nil {
+		return assistant, err
+	}
+	assistant.Status = c.status
+	return assistant, nil
+}
+
+func TestAccAssistantResourceFailed(t *testing.T) {
+	defer func(interval time.Duration) { assistantPollInterval = interval }(assistantPollInterval)
+	assistantPollInterval = time.Millisecond
+
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli := &failedAssistantClient{MockPineconeClient: mock, status: AssistantStatusFailed}
+	config := providerConfig + `
+resource "pinecone_assistant" "test" {
+	name = "docs"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// An assistant that fails is kept in the state
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Assistant\s+docs\s+did\s+not\s+become\s+ready`),
+			},
+			// and replaced by the next apply.
+			{
+				PreConfig: func() {
+					cli.status = AssistantStatusReady
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_assistant.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("pinecone_assistant.test", "status", "Ready"),
+			},
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAssistantClient(t *testing.T) {
+	// Assistants are managed on the control plane, and their files on the host of the assistant.
+	var controlRequests, assistantRequests []string
+	assistantServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "test_api_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assistantRequests = append(assistantRequests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /assistant/files/docs":
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content, _ := io.ReadAll(file)
+			if header.Filename != "guide.md" || string(content) != "# Guide" || r.URL.Query().Get("metadata") != `{"kind":"guide"}` {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"f1","name":"guide.md","status":"Processing"}`))
+		case "GET /assistant/files/docs/f1":
+			_, _ = w.Write([]byte(`{"id":"f1","name":"guide.md","status":"Available","percent_done":1}`))
+		case "GET /assistant/files/docs/f2":
+			_, _ = w.Write([]byte(`{"id":"f2","name":"scan.pdf","status":"ProcessingFailed","error_message":"no text found"}`))
+		case "DELETE /assistant/files/docs/f1":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NOT_FOUND"}}`))
+		}
+	}))
+	defer assistantServer.Close()
+
+	controlServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "test_api_key" || r.Header.Get("X-Pinecone-Api-Version") != controlPlaneAPIVersion {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		controlRequests = append(controlRequests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /assistant/assistants":
+			var req CreateAssistantRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_ = json.NewEncoder(w).Encode(Assistant{Name: req.Name, Instructions: req.Instructions, Status: "Initializing"})
+		case "GET /assistant/assistants/docs":
+			_ = json.NewEncoder(w).Encode(Assistant{Name: "docs", Status: "Ready", Host: assistantServer.URL, Metadata: map[string]any{"team": "search"}})
+		case "PATCH /assistant/assistants/docs":
+			var req UpdateAssistantRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_ = json.NewEncoder(w).Encode(Assistant{Name: "docs", Instructions: req.Instructions, Metadata: req.Metadata, Status: "Ready"})
+		case "DELETE /assistant/assistants/docs":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NOT_FOUND"}}`))
+		}
+	}))
+	defer controlServer.Close()
+
+	ctx := context.Background()
+	cli, err := NewClient("test_api_key", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cli.ControlPlaneBaseURL != "" {
+		t.Fatalf("expected the client to default to %s, got %s", DefaultControlPlaneBaseURL, cli.ControlPlaneBaseURL)
+	}
+	cli.ControlPlaneBaseURL = controlServer.URL
+
+	created, err := cli.CreateAssistant(ctx, CreateAssistantRequest{Name: "docs", Instructions: "Be brief."})
+	if err != nil || created.Name != "docs" || created.Instructions != "Be brief." {
+		t.Fatalf("unexpected created assistant: %+v, %v", created, err)
+	}
+	assistant, err := WaitForAssistant(ctx, cli, "docs")
+	if err != nil || assistant.Host != assistantServer.URL || assistant.Metadata["team"] != "search" {
+		t.Fatalf("unexpected assistant: %+v, %v", assistant, err)
+	}
+	missing, err := cli.DescribeAssistant(ctx, "missing")
+	if err != nil || missing != nil {
+		t.Fatalf("expected no assistant and no error, but received %+v, %v", missing, err)
+	}
+	updated, err := cli.UpdateAssistant(ctx, "docs", UpdateAssistantRequest{Instructions: "Answer in French."})
+	if err != nil || updated.Instructions != "Answer in French." {
+		t.Fatalf("unexpected updated assistant: %+v, %v", updated, err)
+	}
+
+	// Files are sent to the host of the assistant.
+	uploaded, err := cli.UploadAssistantFile(ctx, "docs", "guide.md", strings.NewReader("# Guide"), map[string]any{"kind": "guide"})
+	if err != nil || uploaded.ID != "f1" || uploaded.Status != "Processing" {
+		t.Fatalf("unexpected uploaded file: %+v, %v", uploaded, err)
+	}
+	file, err := WaitForAssistantFile(ctx, cli, "docs", "f1")
+	if err != nil || file.Status != AssistantFileStatusAvailable {
+		t.Fatalf("unexpected file: %+v, %v", file, err)
+	}
+	_, err = WaitForAssistantFile(ctx, cli, "docs", "f2")
+	if err == nil || !strings.Contains(err.Error(), "no text found") {
+		t.Fatalf("expected the processing error, but received %v", err)
+	}
+	missingFile, err := cli.DescribeAssistantFile(ctx, "docs", "missing")
+	if err != nil || missingFile != nil {
+		t.Fatalf("expected no file and no error, but received %+v, %v", missingFile, err)
+	}
+	if err := cli.DeleteAssistantFile(ctx, "docs", "f1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.UploadAssistantFile(ctx, "missing", "guide.md", strings.NewReader(""), nil); err == nil || !strings.Contains(err.Error(), "assistant missing not found") {
+		t.Fatalf("expected an error for a missing assistant, but received %v", err)
+	}
+
+	if err := cli.DeleteAssistant(ctx, "docs"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, request := range controlRequests {
+		if !strings.Contains(request, " /assistant/assistants") {
+			t.Fatalf("expected only assistants on the control plane, got %v", controlRequests)
+		}
+	}
+	if len(controlRequests) != 11 {
+		t.Fatalf("unexpected control plane requests: %v", controlRequests)
+	}
+	if len(assistantRequests) != 5 || assistantRequests[0] != "POST /assistant/files/docs" {
+		t.Fatalf("expected the files on the assistant host, got %v", assistantRequests)
+	}
+}
+
+// processingFileClient reports a file as processing a number of times before it is available.
+type processingFileClient struct {
+	*MockPineconeClient
+	processing int
+}
+
+func (c *processingFileClient) DescribeAssistantFile(ctx context.Context, assistantName string, fileID string) (*AssistantFile, error) {
+	file, err := c.MockPineconeClient.DescribeAssistantFile(ctx, assistantName, fileID)
+	if err != nil || file == nil {
+		return file, err
+	}
+	if c.processing > 0 {
+		c.processing--
+		file.Status = "Processing"
+	}
+	return file, nil
+}
+
+func TestWaitForAssistantFile(t *testing.T) {
+	defer func(interval time.Duration) { assistantPollInterval = interval }(assistantPollInterval)
+	assistantPollInterval = time.Millisecond
+
+	ctx := context.Background()
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mock.CreateAssistant(ctx, CreateAssistantRequest{Name: "docs"}); err != nil {
+		t.Fatal(err)
+	}
+	uploaded, err := mock.UploadAssistantFile(ctx, "docs", "guide.md", strings.NewReader("# Guide"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cli := &processingFileClient{MockPineconeClient: mock, processing: 2}
+	file, err := WaitForAssistantFile(ctx, cli, "docs", uploaded.ID)
+	if err != nil || file.Status != AssistantFileStatusAvailable || cli.processing != 0 {
+		t.Fatalf("unexpected file: %+v, %v", file, err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	cli.processing = 1
+	if _, err := WaitForAssistantFile(cancelled, cli, "docs", uploaded.ID); err != context.Canceled {
+		t.Fatalf("expected the wait to be cancelled, but received %v", err)
+	}
+}
@@ -0,0 +1,246 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// backupPollInterval is how often backups and restore jobs are checked while waiting for them.
+var backupPollInterval = 5 * time.Second
+
+// restoredIndexReadyTimeout is how long the index of a completed restore job may take to become ready.
+var restoredIndexReadyTimeout = 30 * time.Minute
+
+const (
+	BackupStatusReady  = "Ready"
+	BackupStatusFailed = "Failed"
+
+	RestoreJobStatusCompleted = "Completed"
+	RestoreJobStatusFailed    = "Failed"
+)
+
+// Backup is a snapshot of a serverless index, taken by CreateBackup.
+type Backup struct {
+	ID              string `json:"backup_id"`
+	SourceIndexName string `json:"source_index_name"`
+	SourceIndexID   string `json:"source_index_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Status          string `json:"status"`
+	Dimension       int    `json:"dimension"`
+	Metric          Metric `json:"metric"`
+	RecordCount     int    `json:"record_count"`
+	NamespaceCount  int    `json:"namespace_count"`
+	SizeBytes       int64  `json:"size_bytes"`
+	CreatedAt       string `json:"created_at"`
+}
+
+type CreateBackupRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// RestoreJob restores a backup into a new index.
+type RestoreJob struct {
+	ID              string  `json:"restore_job_id"`
+	BackupID        string  `json:"backup_id"`
+	TargetIndexName string  `json:"target_index_name"`
+	TargetIndexID   string  `json:"target_index_id"`
+	Status          string  `json:"status"`
+	PercentComplete float64 `json:"percent_complete"`
+	CreatedAt       string  `json:"created_at"`
+	CompletedAt     string  `json:"completed_at,omitempty"`
+}
+
+type CreateIndexFromBackupRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateIndexFromBackupResponse struct {
+	RestoreJobID string `json:"restore_job_id"`
+	IndexID      string `json:"index_id"`
+}
+
+type listBackupsResponse struct {
+	Data []Backup `json:"data"`
+}
+
+type listRestoreJobsResponse struct {
+	Data []RestoreJob `json:"data"`
+}
+
+// ListBackups lists the backups of every index
+func (c *PineconeClient) ListBackups(ctx context.Context) ([]Backup, error) {
+	var resp listBackupsResponse
+	if _, err := c.do(ctx, "GET", "/backups", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// CreateBackup starts a backup of an index
+func (c *PineconeClient) CreateBackup(ctx context.Context, indexName string, req CreateBackupRequest) (*Backup, error) {
+	var resp Backup
+	if _, err := c.do(ctx, "POST", "/indexes/"+indexName+"/backups", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DescribeBackup describes a backup
+func (c *PineconeClient) DescribeBackup(ctx context.Context, backupID string) (*Backup, error) {
+	var resp Backup
+	status, err := c.do(ctx, "GET", "/backups/"+backupID, nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteBackup deletes a backup
+func (c *PineconeClient) DeleteBackup(ctx context.Context, backupID string) error {
+	_, err := c.do(ctx, "DELETE", "/backups/"+backupID, nil, nil)
+	return err
+}
+
+// CreateIndexFromBackup starts a restore job that creates an index from a backup
+func (c *PineconeClient) CreateIndexFromBackup(ctx context.Context, backupID string, req CreateIndexFromBackupRequest) (*CreateIndexFromBackupResponse, error) {
+	var resp CreateIndexFromBackupResponse
+	if _, err := c.do(ctx, "POST", "/backups/"+backupID+"/create-index", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListRestoreJobs lists the restore jobs of the project
+func (c *PineconeClient) ListRestoreJobs(ctx context.Context) ([]RestoreJob, error) {
+	var resp listRestoreJobsResponse
+	if _, err := c.do(ctx, "GET", "/restore-jobs", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// DescribeRestoreJob describes a restore job
+func (c *PineconeClient) DescribeRestoreJob(ctx context.Context, jobID string) (*RestoreJob, error) {
+	var resp RestoreJob
+	status, err := c.do(ctx, "GET", "/restore-jobs/"+jobID, nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WaitForBackup polls a backup until it is ready, and returns an error if it failed or disappeared.
+func WaitForBackup(ctx context.Context, client PineconeClientInterface, backupID string) (*Backup, error) {
+	for {
+		backup, err := client.DescribeBackup(ctx, backupID)
+		if err != nil {
+			return nil, err
+		}
+		if backup == nil {
+			return nil, fmt.Errorf("error: backup %s not found", backupID)
+		}
+		switch backup.Status {
+		case BackupStatusReady:
+			return backup, nil
+		case BackupStatusFailed:
+			return nil, fmt.Errorf("error: backup %s of index %s failed", backupID, backup.SourceIndexName)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backupPollInterval):
+		}
+	}
+}
+
+// WaitForRestoreJob polls a restore job until it completed and its index is ready,
+// and returns an error if the job failed or disappeared, if its index is missing, or
+// if the index is not ready within restoredIndexReadyTimeout of the job completing.
+func WaitForRestoreJob(ctx context.Context, client PineconeClientInterface, jobID string) (*RestoreJob, error) {
+	var readyDeadline time.Time
+	for {
+		job, err := client.DescribeRestoreJob(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+		if job == nil {
+			return nil, fmt.Errorf("error: restore job %s not found", jobID)
+		}
+		if job.Status == RestoreJobStatusFailed {
+			return nil, fmt.Errorf("error: restore job %s of backup %s failed", jobID, job.BackupID)
+		}
+		if job.Status == RestoreJobStatusCompleted {
+			// Restored indexes are serverless, so they are described through the control plane.
+			index, err := client.DescribeServerlessIndex(ctx, job.TargetIndexName)
+			if err != nil {
+				return nil, err
+			}
+			if index == nil {
+				return nil, fmt.Errorf("error: restore job %s completed, but its index %s was not found", jobID, job.TargetIndexName)
+			}
+			if index.Status.Ready {
+				return job, nil
+			}
+			if readyDeadline.IsZero() {
+				readyDeadline = time.Now().Add(restoredIndexReadyTimeout)
+			} else if time.Now().After(readyDeadline) {
+				return nil, fmt.Errorf("error: index %s of restore job %s is not ready %s after the job completed",
+					job.TargetIndexName, jobID, restoredIndexReadyTimeout)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backupPollInterval):
+		}
+	}
+}
+
+// WaitForIndexDeleted polls an index until it no longer exists.
+func WaitForIndexDeleted(ctx context.Context, client PineconeClientInterface, indexName string) error {
+	for {
+		index, err := client.DescribeIndex(ctx, indexName)
+		if err != nil {
+			return err
+		}
+		if index == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backupPollInterval):
+		}
+	}
+}
+
+// sortBackups sorts backups oldest first, and by ID when created at the same time.
+func sortBackups(backups []Backup) {
+	sort.SliceStable(backups, func(i, j int) bool {
+		if backups[i].CreatedAt != backups[j].CreatedAt {
+			return backups[i].CreatedAt < backups[j].CreatedAt
+		}
+		return backups[i].ID < backups[j].ID
+	})
+}
+
+// sortRestoreJobs sorts restore jobs oldest first, keeping the order of jobs started at the same time.
+func sortRestoreJobs(jobs []RestoreJob) {
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt < jobs[j].CreatedAt
+	})
+}
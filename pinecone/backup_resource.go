@@ -0,0 +1,290 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &backupResource{}
+	_ resource.ResourceWithConfigure   = &backupResource{}
+	_ resource.ResourceWithImportState = &backupResource{}
+)
+
+// NewBackupResource is a helper function to simplify the provider implementation.
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+// backupResource is the resource implementation.
+type backupResource struct {
+	client PineconeClientInterface
+}
+
+type backupResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SourceIndexName types.String `tfsdk:"source_index_name"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Status          types.String `tfsdk:"status"`
+	Dimension       types.Int64  `tfsdk:"dimension"`
+	Metric          types.String `tfsdk:"metric"`
+	RecordCount     types.Int64  `tfsdk:"record_count"`
+	NamespaceCount  types.Int64  `tfsdk:"namespace_count"`
+	SizeBytes       types.Int64  `tfsdk:"size_bytes"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+// setBackup sets the model from a backup returned by Pinecone. An empty name or
+// description stays null, so that leaving them out of the configuration is not drift.
+func (m *backupResourceModel) setBackup(backup *Backup) {
+	m.ID = types.StringValue(backup.ID)
+	m.SourceIndexName = types.StringValue(backup.SourceIndexName)
+	if backup.Name != "" || !m.Name.IsNull() {
+		m.Name = types.StringValue(backup.Name)
+	}
+	if backup.Description != "" || !m.Description.IsNull() {
+		m.Description = types.StringValue(backup.Description)
+	}
+	m.Status = types.StringValue(backup.Status)
+	m.Dimension = types.Int64Value(int64(backup.Dimension))
+	m.Metric = types.StringValue(backup.Metric.String())
+	m.RecordCount = types.Int64Value(int64(backup.RecordCount))
+	m.NamespaceCount = types.Int64Value(int64(backup.NamespaceCount))
+	m.SizeBytes = types.Int64Value(backup.SizeBytes)
+	m.CreatedAt = types.StringValue(backup.CreatedAt)
+}
+
+// Metadata returns the resource type name.
+func (r *backupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+// Schema defines the schema for the resource.
+func (r *backupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Back up a serverless index. Terraform waits until the backup is ready. " +
+			"Any change replaces the backup with a new one; restore it with pinecone_restore_job.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the backup.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_index_name": schema.StringAttribute{
+				Description: "The name of the index to back up.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the backup, e.g. Ready.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dimension": schema.Int64Attribute{
+				Description: "The dimension of the backed up index.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"metric": schema.StringAttribute{
+				Description: "The metric of the backed up index.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"record_count": schema.Int64Attribute{
+				Description: "The number of vectors in the backup.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace_count": schema.Int64Attribute{
+				Description: "The number of namespaces in the backup.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				Description: "The size of the backup in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the backup was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create backs up the index and waits until the backup is ready.
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan backupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.CreateBackup(ctx, plan.SourceIndexName.ValueString(), CreateBackupRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating backup",
+			"Could not create backup, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save the backup before waiting, so that it is not orphaned when waiting fails.
+	// Terraform then taints it, and replacing it deletes the backup.
+	plan.setBackup(backup)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err = WaitForBackup(ctx, r.client, backup.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating backup",
+			"Backup "+plan.ID.ValueString()+" did not become ready: "+err.Error(),
+		)
+		return
+	}
+	plan.setBackup(backup)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state backupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.DescribeBackup(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Backup",
+			"Could not read Pinecone Backup ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// If the backup is not found, remove it from the state
+	if backup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.setBackup(backup)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called with changes, as every argument replaces the backup.
+func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan backupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state backupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBackup(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting backup",
+			"Could not delete backup, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *backupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+}
+
+func (r *backupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,163 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// newMockClientWithMovies returns a mock client with a movies index of three vectors in two namespaces.
+func newMockClientWithMovies(t *testing.T) *MockPineconeClient {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.CreateIndex(ctx, CreateIndexRequest{Name: "movies", Dimension: 2, Metric: MetricCosine}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Upsert(ctx, "movies", UpsertRequest{Vectors: []Vector{{ID: "a", Values: []float32{1, 0}}, {ID: "b", Values: []float32{0, 1}}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Upsert(ctx, "movies", UpsertRequest{Namespace: "drama", Vectors: []Vector{{ID: "c", Values: []float32{1, 1}}}}); err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestAccBackupResource(t *testing.T) {
+	cli := newMockClientWithMovies(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		CheckDestroy: func(s *terraform.State) error {
+			backups, err := cli.ListBackups(context.Background())
+			if err != nil {
+				return err
+			}
+			if len(backups) != 0 {
+				return fmt.Errorf("expected no backups, but found %+v", backups)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "pinecone_backup" "test" {
+    source_index_name = "movies"
+    name              = "nightly"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_backup.test", "id", "mock-backup-1"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "source_index_name", "movies"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "name", "nightly"),
+					resource.TestCheckNoResourceAttr("pinecone_backup.test", "description"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "status", "Ready"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "dimension", "2"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "metric", "cosine"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "record_count", "3"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "namespace_count", "2"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "size_bytes", "24"),
+					resource.TestCheckResourceAttrSet("pinecone_backup.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pinecone_backup.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A new description replaces the backup
+			{
+				Config: providerConfig + `
+resource "pinecone_backup" "test" {
+    source_index_name = "movies"
+    name              = "nightly"
+    description       = "before the migration"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_backup.test", "id", "mock-backup-2"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "description", "before the migration"),
+					func(s *terraform.State) error {
+						backups, err := cli.ListBackups(context.Background())
+						if err != nil {
+							return err
+						}
+						if len(backups) != 1 {
+							return fmt.Errorf("expected the replaced backup to be deleted, but found %+v", backups)
+						}
+						return nil
+					},
+				),
+			},
+			// A backup deleted outside Terraform is created again
+			{
+				PreConfig: func() {
+					_ = cli.DeleteBackup(context.Background(), "mock-backup-2")
+				},
+				Config: providerConfig + `
+resource "pinecone_backup" "test" {
+    source_index_name = "movies"
+    name              = "nightly"
+    description       = "before the migration"
+}
+`,
+				Check: resource.TestCheckResourceAttr("pinecone_backup.test", "id", "mock-backup-3"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBackupResourceFailed(t *testing.T) {
+	interval := backupPollInterval
+	backupPollInterval = time.Millisecond
+	defer func() { backupPollInterval = interval }()
+
+	cli := &pendingBackupClient{MockPineconeClient: newMockClientWithMovies(t), status: BackupStatusFailed}
+	config := providerConfig + `
+resource "pinecone_backup" "test" {
+    source_index_name = "movies"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// A backup that fails is kept in the state
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Backup\s+mock-backup-1\s+did\s+not\s+become\s+ready`),
+			},
+			// and replaced by the next apply
+			{
+				PreConfig: func() {
+					cli.status = BackupStatusReady
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_backup.test", "id", "mock-backup-2"),
+					resource.TestCheckResourceAttr("pinecone_backup.test", "status", "Ready"),
+					func(s *terraform.State) error {
+						backups, err := cli.ListBackups(context.Background())
+						if err != nil {
+							return err
+						}
+						if len(backups) != 1 {
+							return fmt.Errorf("expected the failed backup to be deleted, but found %+v", backups)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
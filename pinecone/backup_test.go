@@ -0,0 +1,282 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBackupClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "test_api_key" || r.Header.Get("X-Pinecone-Api-Version") != controlPlaneAPIVersion {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// Backups and restore jobs are served by the global control plane, not the controller of the environment.
+		if r.Host != "api.pinecone.io" {
+			t.Errorf("unexpected host %s for %s %s", r.Host, r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMisdirectedRequest)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /backups":
+			_, _ = w.Write([]byte(`{"data":[{"backup_id":"b1","source_index_name":"movies","status":"Ready","record_count":3}]}`))
+		case "POST /indexes/movies/backups":
+			var req CreateBackupRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_ = json.NewEncoder(w).Encode(Backup{ID: "b2", SourceIndexName: "movies", Name: req.Name, Status: "Initializing"})
+		case "GET /backups/b2":
+			_, _ = w.Write([]byte(`{"backup_id":"b2","source_index_name":"movies","status":"Ready","metric":"dotproduct","size_bytes":1024}`))
+		case "DELETE /backups/b2":
+			w.WriteHeader(http.StatusAccepted)
+		case "POST /backups/b2/create-index":
+			var req CreateIndexFromBackupRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.Name != "restored" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"restore_job_id":"r1","index_id":"i1"}`))
+		case "GET /restore-jobs":
+			_, _ = w.Write([]byte(`{"data":[{"restore_job_id":"r1","backup_id":"b2","status":"Pending"}]}`))
+		case "GET /restore-jobs/r1":
+			_, _ = w.Write([]byte(`{"restore_job_id":"r1","backup_id":"b2","target_index_name":"restored","status":"Completed","percent_complete":100}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NOT_FOUND"}}`))
+		}
+	}))
+	defer server.Close()
+	target, _ := url.Parse(server.URL)
+
+	ctx := context.Background()
+	cli, err := NewClient("test_api_key", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli.HTTPClient = &http.Client{Transport: &rewriteTransport{target: target}}
+
+	backups, err := cli.ListBackups(ctx)
+	if err != nil || len(backups) != 1 || backups[0].RecordCount != 3 {
+		t.Fatalf("unexpected backups: %+v, %v", backups, err)
+	}
+
+	created, err := cli.CreateBackup(ctx, "movies", CreateBackupRequest{Name: "nightly"})
+	if err != nil || created.ID != "b2" || created.Name != "nightly" {
+		t.Fatalf("unexpected created backup: %+v, %v", created, err)
+	}
+
+	described, err := cli.DescribeBackup(ctx, "b2")
+	if err != nil || described.Metric != MetricDotProduct || described.SizeBytes != 1024 {
+		t.Fatalf("unexpected described backup: %+v, %v", described, err)
+	}
+	missing, err := cli.DescribeBackup(ctx, "missing")
+	if err != nil || missing != nil {
+		t.Fatalf("expected no backup and no error, but received %+v, %v", missing, err)
+	}
+
+	restored, err := cli.CreateIndexFromBackup(ctx, "b2", CreateIndexFromBackupRequest{Name: "restored"})
+	if err != nil || restored.RestoreJobID != "r1" || restored.IndexID != "i1" {
+		t.Fatalf("unexpected restore: %+v, %v", restored, err)
+	}
+
+	jobs, err := cli.ListRestoreJobs(ctx)
+	if err != nil || len(jobs) != 1 || jobs[0].Status != "Pending" {
+		t.Fatalf("unexpected restore jobs: %+v, %v", jobs, err)
+	}
+	job, err := cli.DescribeRestoreJob(ctx, "r1")
+	if err != nil || job.Status != RestoreJobStatusCompleted || job.PercentComplete != 100 {
+		t.Fatalf("unexpected restore job: %+v, %v", job, err)
+	}
+	missingJob, err := cli.DescribeRestoreJob(ctx, "missing")
+	if err != nil || missingJob != nil {
+		t.Fatalf("expected no restore job and no error, but received %+v, %v", missingJob, err)
+	}
+
+	if err := cli.DeleteBackup(ctx, "b2"); err != nil {
+		t.Fatal(err)
+	}
+	err = cli.DeleteBackup(ctx, "missing")
+	if err == nil || !strings.Contains(err.Error(), "404") || !strings.Contains(err.Error(), "NOT_FOUND") {
+		t.Fatalf("expected the status and body in the error, but received %v", err)
+	}
+
+	if len(requests) != 10 {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+}
+
+func TestMockBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.CreateIndex(ctx, CreateIndexRequest{Name: "movies", Dimension: 2, Metric: MetricCosine}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Upsert(ctx, "movies", UpsertRequest{Vectors: []Vector{{ID: "a", Values: []float32{1, 0}}, {ID: "b", Values: []float32{0, 1}}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Upsert(ctx, "movies", UpsertRequest{Namespace: "drama", Vectors: []Vector{{ID: "c", Values: []float32{1, 1}}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	backup, err := cli.CreateBackup(ctx, "movies", CreateBackupRequest{Name: "nightly"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backup.RecordCount != 3 || backup.NamespaceCount != 2 || backup.Dimension != 2 || backup.Status != BackupStatusReady {
+		t.Fatalf("unexpected backup: %+v", backup)
+	}
+
+	// Later writes are not in the backup.
+	if err := cli.Delete(ctx, "movies", DeleteRequest{DeleteAll: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, err := cli.CreateIndexFromBackup(ctx, backup.ID, CreateIndexFromBackupRequest{Name: "restored"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job, err := WaitForRestoreJob(ctx, cli, restored.RestoreJobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.TargetIndexName != "restored" || job.BackupID != backup.ID {
+		t.Fatalf("unexpected restore job: %+v", job)
+	}
+	stats, err := cli.DescribeIndexStats(ctx, "restored", DescribeIndexStatsRequest{})
+	if err != nil || stats.TotalVectorCount != 3 || stats.Namespaces["drama"].VectorCount != 1 {
+		t.Fatalf("unexpected stats of the restored index: %+v, %v", stats, err)
+	}
+
+	if _, err := cli.CreateIndexFromBackup(ctx, backup.ID, CreateIndexFromBackupRequest{Name: "restored"}); err == nil {
+		t.Fatal("expected an error restoring into an existing index")
+	}
+	if err := cli.DeleteIndex(ctx, "restored"); err != nil {
+		t.Fatal(err)
+	}
+	if err := WaitForIndexDeleted(ctx, cli, "restored"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cli.DeleteBackup(ctx, backup.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.CreateIndexFromBackup(ctx, backup.ID, CreateIndexFromBackupRequest{Name: "again"}); err == nil {
+		t.Fatal("expected an error restoring a deleted backup")
+	}
+}
+
+// pendingBackupClient reports backups as initializing for the first describes.
+type pendingBackupClient struct {
+	*MockPineconeClient
+	pending int
+	status  string
+}
+
+func (c *pendingBackupClient) DescribeBackup(ctx context.Context, backupID string) (*Backup, error) {
+	backup, err := c.MockPineconeClient.DescribeBackup(ctx, backupID)
+	if backup == nil || err != nil {
+		return backup, err
+	}
+	if c.pending > 0 {
+		c.pending--
+		backup.Status = "Initializing"
+		return backup, nil
+	}
+	backup.Status = c.status
+	return backup, nil
+}
+
+func TestWaitForBackup(t *testing.T) {
+	interval := backupPollInterval
+	backupPollInterval = time.Millisecond
+	defer func() { backupPollInterval = interval }()
+
+	ctx := context.Background()
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.CreateIndex(ctx, CreateIndexRequest{Name: "movies", Dimension: 2}); err != nil {
+		t.Fatal(err)
+	}
+	backup, err := mock.CreateBackup(ctx, "movies", CreateBackupRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cli := &pendingBackupClient{MockPineconeClient: mock, pending: 2, status: BackupStatusReady}
+	ready, err := WaitForBackup(ctx, cli, backup.ID)
+	if err != nil || ready.Status != BackupStatusReady || cli.pending != 0 {
+		t.Fatalf("expected to wait until the backup is ready, but received %+v, %v", ready, err)
+	}
+
+	cli = &pendingBackupClient{MockPineconeClient: mock, pending: 1, status: BackupStatusFailed}
+	if _, err := WaitForBackup(ctx, cli, backup.ID); err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Fatalf("expected the backup to fail, but received %v", err)
+	}
+
+	if _, err := WaitForBackup(ctx, mock, "missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a missing backup, but received %v", err)
+	}
+}
+
+// unreadyIndexClient reports every index as not ready.
+type unreadyIndexClient struct {
+	*MockPineconeClient
+}
+
+func (c *unreadyIndexClient) DescribeServerlessIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error) {
+	index, err := c.MockPineconeClient.DescribeServerlessIndex(ctx, indexName)
+	if index != nil {
+		index.Status.Ready = false
+	}
+	return index, err
+}
+
+func TestWaitForRestoreJob(t *testing.T) {
+	interval, timeout := backupPollInterval, restoredIndexReadyTimeout
+	backupPollInterval, restoredIndexReadyTimeout = time.Millisecond, 10*time.Millisecond
+	defer func() { backupPollInterval, restoredIndexReadyTimeout = interval, timeout }()
+
+	ctx := context.Background()
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.CreateIndex(ctx, CreateIndexRequest{Name: "movies", Dimension: 2}); err != nil {
+		t.Fatal(err)
+	}
+	backup, err := mock.CreateBackup(ctx, "movies", CreateBackupRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, err := mock.CreateIndexFromBackup(ctx, backup.ID, CreateIndexFromBackupRequest{Name: "restored"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cli := &unreadyIndexClient{MockPineconeClient: mock}
+	if _, err := WaitForRestoreJob(ctx, cli, restored.RestoreJobID); err == nil || !strings.Contains(err.Error(), "is not ready") {
+		t.Fatalf("expected the restored index not to become ready, but received %v", err)
+	}
+
+	if err := mock.DeleteIndex(ctx, "restored"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := WaitForRestoreJob(ctx, mock, restored.RestoreJobID); err == nil || !strings.Contains(err.Error(), "index restored was not found") {
+		t.Fatalf("expected the restored index to be missing, but received %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &backupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &backupsDataSource{}
+)
+
+// NewBackupsDataSource is a helper function to simplify the provider implementation.
+func NewBackupsDataSource() datasource.DataSource {
+	return &backupsDataSource{}
+}
+
+// backupsDataSource is the data source implementation.
+type backupsDataSource struct {
+	client PineconeClientInterface
+}
+
+type backupsDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IndexName types.String `tfsdk:"index_name"`
+	Backups   types.List   `tfsdk:"backups"`
+}
+
+var backupAttributeTypes = map[string]attr.Type{
+	"id":                types.StringType,
+	"source_index_name": types.StringType,
+	"name":              types.StringType,
+	"description":       types.StringType,
+	"status":            types.StringType,
+	"dimension":         types.Int64Type,
+	"metric":            types.StringType,
+	"record_count":      types.Int64Type,
+	"namespace_count":   types.Int64Type,
+	"size_bytes":        types.Int64Type,
+	"created_at":        types.StringType,
+}
+
+// Metadata returns the data source type name.
+func (d *backupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+// Schema defines the schema for the data source.
+func (d *backupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the backups of the project's indexes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the backup list.",
+				Computed:    true,
+			},
+			"index_name": schema.StringAttribute{
+				Description: "Only list the backups of this index.",
+				Optional:    true,
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "The backups, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the backup.",
+							Computed:    true,
+						},
+						"source_index_name": schema.StringAttribute{
+							Description: "The name of the backed up index.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the backup.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the backup.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the backup, e.g. Ready.",
+							Computed:    true,
+						},
+						"dimension": schema.Int64Attribute{
+							Description: "The dimension of the backed up index.",
+							Computed:    true,
+						},
+						"metric": schema.StringAttribute{
+							Description: "The metric of the backed up index.",
+							Computed:    true,
+						},
+						"record_count": schema.Int64Attribute{
+							Description: "The number of vectors in the backup.",
+							Computed:    true,
+						},
+						"namespace_count": schema.Int64Attribute{
+							Description: "The number of namespaces in the backup.",
+							Computed:    true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							Description: "The size of the backup in bytes.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the backup was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data backupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := d.client.ListBackups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error ListBackups", err.Error())
+		return
+	}
+	sortBackups(backups)
+
+	elements := make([]attr.Value, 0, len(backups))
+	for _, backup := range backups {
+		if !data.IndexName.IsNull() && backup.SourceIndexName != data.IndexName.ValueString() {
+			continue
+		}
+		object, diags := types.ObjectValue(backupAttributeTypes, map[string]attr.Value{
+			"id":                types.StringValue(backup.ID),
+			"source_index_name": types.StringValue(backup.SourceIndexName),
+			"name":              types.StringValue(backup.Name),
+			"description":       types.StringValue(backup.Description),
+			"status":            types.StringValue(backup.Status),
+			"dimension":         types.Int64Value(int64(backup.Dimension)),
+			"metric":            types.StringValue(backup.Metric.String()),
+			"record_count":      types.Int64Value(int64(backup.RecordCount)),
+			"namespace_count":   types.Int64Value(int64(backup.NamespaceCount)),
+			"size_bytes":        types.Int64Value(backup.SizeBytes),
+			"created_at":        types.StringValue(backup.CreatedAt),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elements = append(elements, object)
+	}
+	backupsValue, diags := types.ListValue(types.ObjectType{AttrTypes: backupAttributeTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("backups")
+	if !data.IndexName.IsNull() {
+		data.ID = types.StringValue(data.IndexName.ValueString())
+	}
+	data.Backups = backupsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *backupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	d.client = data.client
+}
@@ -0,0 +1,54 @@
+package pinecone
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupsDataSource(t *testing.T) {
+	ctx := context.Background()
+	cli := newMockClientWithMovies(t)
+	if err := cli.CreateIndex(ctx, CreateIndexRequest{Name: "books", Dimension: 3, Metric: MetricEuclidean}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.CreateBackup(ctx, "movies", CreateBackupRequest{Name: "nightly", Description: "every night"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.CreateBackup(ctx, "books", CreateBackupRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_backups" "all" {}
+
+data "pinecone_backups" "movies" {
+    index_name = "movies"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_backups.all", "id", "backups"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.all", "backups.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.all", "backups.1.source_index_name", "books"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.all", "backups.1.metric", "euclidean"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.all", "backups.1.record_count", "0"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "id", "movies"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.#", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.0.id", "mock-backup-1"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.0.name", "nightly"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.0.description", "every night"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.0.status", "Ready"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.0.dimension", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.0.record_count", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_backups.movies", "backups.0.namespace_count", "2"),
+					resource.TestCheckResourceAttrSet("data.pinecone_backups.movies", "backups.0.created_at"),
+				),
+			},
+		},
+	})
+}
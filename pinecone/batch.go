@@ -0,0 +1,59 @@
+package pinecone
+
+import (
+	"context"
+	"sync"
+)
+
+// forEachBatch calls f for the half-open ranges [start, end) that split n items
+// into batches of batchSize, running at most parallelism calls at once. It
+// stops starting new batches after the first error and returns that error.
+func forEachBatch(ctx context.Context, n int, batchSize int, parallelism int, f func(ctx context.Context, start int, end int) error) error {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, parallelism)
+	for start := 0; start < n; start += batchSize {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		end := min(start+batchSize, n)
+		wg.Add(1)
+		go func(start int, end int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := f(ctx, start, end); err != nil {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mutex.Unlock()
+				cancel()
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,66 @@
+package pinecone
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachBatch(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		mutex   sync.Mutex
+		covered = make([]int, 10)
+		running atomic.Int32
+		peak    atomic.Int32
+	)
+	err := forEachBatch(ctx, 10, 3, 2, func(ctx context.Context, start int, end int) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if current <= p || peak.CompareAndSwap(p, current) {
+				break
+			}
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		for i := start; i < end; i++ {
+			covered[i]++
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, c := range covered {
+		if c != 1 {
+			t.Fatalf("expected item %d to be covered once, but it was covered %d times", i, c)
+		}
+	}
+	if peak.Load() > 2 {
+		t.Fatalf("expected at most 2 batches at once, but %d ran", peak.Load())
+	}
+
+	boom := errors.New("boom")
+	err = forEachBatch(ctx, 10, 1, 1, func(ctx context.Context, start int, end int) error {
+		if start == 3 {
+			return boom
+		}
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom, but received %v", err)
+	}
+
+	if err := forEachBatch(ctx, 0, 3, 2, func(ctx context.Context, start int, end int) error {
+		t.Fatal("expected no batches")
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,111 @@
+package pinecone
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// capacityReferenceDimension is the dimension the pod capacities below are quoted for.
+const capacityReferenceDimension = 768
+
+// podClassCapacity is what a single x1 pod of a class can hold and serve.
+type podClassCapacity struct {
+	// Vectors is how many vectors of capacityReferenceDimension dimensions without metadata fit in one x1 pod.
+	Vectors int
+	// QPSPerReplica is the queries per second one replica sustains; it does not grow with pod size.
+	QPSPerReplica float64
+}
+
+// podClassCapacities is the built-in capacity table, from Pinecone's published pod sizing guidance.
+var podClassCapacities = map[string]podClassCapacity{
+	"s1": {Vectors: 5_000_000, QPSPerReplica: 10},
+	"p1": {Vectors: 1_000_000, QPSPerReplica: 30},
+	"p2": {Vectors: 1_100_000, QPSPerReplica: 200},
+}
+
+// podSizes lists pod sizes from smallest to largest.
+var podSizes = []string{"x1", "x2", "x4", "x8"}
+
+type CapacityPlanRequest struct {
+	VectorCount            int
+	Dimension              int
+	MetadataBytesPerVector int
+	// TargetQPS of zero means a single replica is enough.
+	TargetQPS float64
+	// PodClass restricts the plan to one pod class; empty considers every class.
+	PodClass string
+}
+
+type CapacityPlan struct {
+	PodType  PodType
+	Pods     int
+	Replicas int
+	// EstimatedMonthlyCostUSD is zero when the pod type has no known price.
+	EstimatedMonthlyCostUSD float64
+}
+
+// PlanCapacity recommends the cheapest pod type, pods and replicas that hold the
+// vectors and serve the target QPS. Ties prefer fewer, larger pods.
+func PlanCapacity(req CapacityPlanRequest, pricing *PricingCatalog) (*CapacityPlan, error) {
+	if req.VectorCount < 1 {
+		return nil, fmt.Errorf("error: vector count must be at least 1: %d", req.VectorCount)
+	}
+	if req.Dimension < 1 {
+		return nil, fmt.Errorf("error: dimension must be at least 1: %d", req.Dimension)
+	}
+	if req.MetadataBytesPerVector < 0 {
+		return nil, fmt.Errorf("error: metadata bytes per vector must not be negative: %d", req.MetadataBytesPerVector)
+	}
+	if req.TargetQPS < 0 {
+		return nil, fmt.Errorf("error: target qps must not be negative: %v", req.TargetQPS)
+	}
+
+	classes := make([]string, 0, len(podClassCapacities))
+	for class := range podClassCapacities {
+		if req.PodClass == "" || req.PodClass == class {
+			classes = append(classes, class)
+		}
+	}
+	if len(classes) == 0 {
+		return nil, fmt.Errorf("error: invalid pod class: %s", req.PodClass)
+	}
+	sort.Strings(classes)
+
+	// Vectors are stored as float32 values alongside their metadata.
+	bytesPerVector := float64(req.Dimension*4 + req.MetadataBytesPerVector)
+
+	var best *CapacityPlan
+	for _, class := range classes {
+		capacity := podClassCapacities[class]
+		podBytes := float64(capacity.Vectors) * capacityReferenceDimension * 4
+
+		replicas := 1
+		if req.TargetQPS > 0 {
+			replicas = int(math.Ceil(req.TargetQPS / capacity.QPSPerReplica))
+		}
+
+		for _, size := range podSizes {
+			vectorsPerPod := math.Floor(podBytes * float64(podSizeMultipliers[size]) / bytesPerVector)
+			if vectorsPerPod < 1 {
+				continue
+			}
+			candidate := &CapacityPlan{
+				PodType:  PodType{Class: class, Size: size},
+				Pods:     int(math.Ceil(float64(req.VectorCount) / vectorsPerPod)),
+				Replicas: replicas,
+			}
+			candidate.EstimatedMonthlyCostUSD, _ = pricing.EstimateMonthlyCost(candidate.PodType, candidate.Pods, candidate.Replicas)
+
+			if best == nil || candidate.EstimatedMonthlyCostUSD < best.EstimatedMonthlyCostUSD ||
+				(candidate.EstimatedMonthlyCostUSD == best.EstimatedMonthlyCostUSD && candidate.Pods*candidate.Replicas < best.Pods*best.Replicas) {
+				best = candidate
+			}
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("error: a single vector of %d bytes does not fit in any pod", int(bytesPerVector))
+	}
+	return best, nil
+}
@@ -0,0 +1,179 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &capacityPlanDataSource{}
+	_ datasource.DataSourceWithConfigure      = &capacityPlanDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &capacityPlanDataSource{}
+)
+
+// NewCapacityPlanDataSource is a helper function to simplify the provider implementation.
+func NewCapacityPlanDataSource() datasource.DataSource {
+	return &capacityPlanDataSource{}
+}
+
+// capacityPlanDataSource is the data source implementation.
+type capacityPlanDataSource struct {
+	pricing *PricingCatalog
+}
+
+type capacityPlanDataSourceModel struct {
+	ID                     types.String  `tfsdk:"id"`
+	VectorCount            types.Int64   `tfsdk:"vector_count"`
+	Dimension              types.Int64   `tfsdk:"dimension"`
+	MetadataBytesPerVector types.Int64   `tfsdk:"metadata_bytes_per_vector"`
+	TargetQPS              types.Float64 `tfsdk:"target_qps"`
+	PodClass               types.String  `tfsdk:"pod_class"`
+
+	PodType                 types.String  `tfsdk:"pod_type"`
+	Pods                    types.Int64   `tfsdk:"pods"`
+	Replicas                types.Int64   `tfsdk:"replicas"`
+	EstimatedMonthlyCostUSD types.Float64 `tfsdk:"estimated_monthly_cost_usd"`
+}
+
+// Metadata returns the data source type name.
+func (d *capacityPlanDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capacity_plan"
+}
+
+// Schema defines the schema for the data source.
+func (d *capacityPlanDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Recommend the cheapest pod type, pods and replicas for an index from its expected size and load, using a capacity table built into the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the capacity plan.",
+				Computed:    true,
+			},
+			"vector_count": schema.Int64Attribute{
+				Description: "The expected number of vectors.",
+				Required:    true,
+			},
+			"dimension": schema.Int64Attribute{
+				Description: "The dimension of the vectors.",
+				Required:    true,
+			},
+			"metadata_bytes_per_vector": schema.Int64Attribute{
+				Description: "The average size of the metadata stored with each vector, in bytes. Defaults to 0.",
+				Optional:    true,
+			},
+			"target_qps": schema.Float64Attribute{
+				Description: "The queries per second the index must serve. Defaults to what a single replica serves.",
+				Optional:    true,
+			},
+			"pod_class": schema.StringAttribute{
+				Description: "Only consider this pod class (s1, p1 or p2). Defaults to every class.",
+				Optional:    true,
+			},
+			"pod_type": schema.StringAttribute{
+				Description: "The recommended pod type.",
+				Computed:    true,
+			},
+			"pods": schema.Int64Attribute{
+				Description: "The recommended number of pods.",
+				Computed:    true,
+			},
+			"replicas": schema.Int64Attribute{
+				Description: "The recommended number of replicas.",
+				Computed:    true,
+			},
+			"estimated_monthly_cost_usd": schema.Float64Attribute{
+				Description: "The estimated monthly cost of the recommendation in USD.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks each argument, so that errors point at the argument to fix.
+func (d *capacityPlanDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config capacityPlanDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.VectorCount.IsNull() && !config.VectorCount.IsUnknown() && config.VectorCount.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("vector_count"), "Invalid vector count",
+			fmt.Sprintf("vector_count must be at least 1, got %d.", config.VectorCount.ValueInt64()))
+	}
+	if !config.Dimension.IsNull() && !config.Dimension.IsUnknown() && config.Dimension.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("dimension"), "Invalid dimension",
+			fmt.Sprintf("dimension must be at least 1, got %d.", config.Dimension.ValueInt64()))
+	}
+	if !config.MetadataBytesPerVector.IsNull() && !config.MetadataBytesPerVector.IsUnknown() && config.MetadataBytesPerVector.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata_bytes_per_vector"), "Invalid metadata bytes per vector",
+			fmt.Sprintf("metadata_bytes_per_vector must not be negative, got %d.", config.MetadataBytesPerVector.ValueInt64()))
+	}
+	if !config.TargetQPS.IsNull() && !config.TargetQPS.IsUnknown() && config.TargetQPS.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("target_qps"), "Invalid target QPS",
+			fmt.Sprintf("target_qps must not be negative, got %v.", config.TargetQPS.ValueFloat64()))
+	}
+	if !config.PodClass.IsNull() && !config.PodClass.IsUnknown() {
+		if _, ok := podClassCapacities[config.PodClass.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("pod_class"), "Invalid pod class",
+				fmt.Sprintf("pod_class must be s1, p1 or p2, got %q.", config.PodClass.ValueString()))
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *capacityPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data capacityPlanDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, err := PlanCapacity(CapacityPlanRequest{
+		VectorCount:            int(data.VectorCount.ValueInt64()),
+		Dimension:              int(data.Dimension.ValueInt64()),
+		MetadataBytesPerVector: int(data.MetadataBytesPerVector.ValueInt64()),
+		TargetQPS:              data.TargetQPS.ValueFloat64(),
+		PodClass:               data.PodClass.ValueString(),
+	}, d.pricing)
+	if err != nil {
+		// The arguments are valid, but a vector of this dimension and metadata fits in no pod.
+		resp.Diagnostics.AddError("Error planning capacity", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d/%d", plan.PodType, plan.Pods, plan.Replicas))
+	data.PodType = types.StringValue(plan.PodType.String())
+	data.Pods = types.Int64Value(int64(plan.Pods))
+	data.Replicas = types.Int64Value(int64(plan.Replicas))
+	data.EstimatedMonthlyCostUSD = types.Float64Value(plan.EstimatedMonthlyCostUSD)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured pricing to the data source.
+func (d *capacityPlanDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+
+	d.pricing = data.pricing
+}
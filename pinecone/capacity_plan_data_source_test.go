@@ -0,0 +1,135 @@
+package pinecone
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPlanCapacity(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      CapacityPlanRequest
+		expected CapacityPlan
+	}{
+		{
+			name:     "Storage bound picks s1",
+			req:      CapacityPlanRequest{VectorCount: 1_000_000, Dimension: 1536},
+			expected: CapacityPlan{PodType: PodType{Class: "s1", Size: "x1"}, Pods: 1, Replicas: 1, EstimatedMonthlyCostUSD: 70.08},
+		},
+		{
+			name:     "Metadata needs more space",
+			req:      CapacityPlanRequest{VectorCount: 10_000_000, Dimension: 1536, MetadataBytesPerVector: 6144},
+			expected: CapacityPlan{PodType: PodType{Class: "s1", Size: "x8"}, Pods: 1, Replicas: 1, EstimatedMonthlyCostUSD: 560.64},
+		},
+		{
+			name:     "QPS bound picks p2 with the larger size",
+			req:      CapacityPlanRequest{VectorCount: 2_000_000, Dimension: 768, TargetQPS: 100},
+			expected: CapacityPlan{PodType: PodType{Class: "p2", Size: "x2"}, Pods: 1, Replicas: 1, EstimatedMonthlyCostUSD: 210.24},
+		},
+		{
+			name:     "Restricted to p1",
+			req:      CapacityPlanRequest{VectorCount: 2_000_000, Dimension: 768, TargetQPS: 100, PodClass: "p1"},
+			expected: CapacityPlan{PodType: PodType{Class: "p1", Size: "x2"}, Pods: 1, Replicas: 4, EstimatedMonthlyCostUSD: 560.64},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := PlanCapacity(tc.req, nil)
+			if err != nil {
+				t.Fatalf("test '%s' failed: expected no error, but received error: %v", tc.name, err)
+			}
+			if *plan != tc.expected {
+				t.Fatalf("test '%s' failed: expected %v, but received %v", tc.name, tc.expected, *plan)
+			}
+		})
+	}
+
+	if _, err := PlanCapacity(CapacityPlanRequest{VectorCount: 1, Dimension: 1, PodClass: "s9"}, nil); err == nil {
+		t.Fatal("expected an error for an unknown pod class")
+	}
+}
+
+func TestAccCapacityPlanDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_capacity_plan" "test" {
+    vector_count = 2000000
+    dimension    = 768
+    target_qps   = 100
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_capacity_plan.test", "pod_type", "p2.x2"),
+					resource.TestCheckResourceAttr("data.pinecone_capacity_plan.test", "pods", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_capacity_plan.test", "replicas", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_capacity_plan.test", "estimated_monthly_cost_usd", "210.24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCapacityPlanDataSourceInvalidConfig(t *testing.T) {
+	// The error points at the invalid argument, whose line Terraform shows after it.
+	testCases := []struct {
+		name      string
+		arguments string
+		err       string
+	}{
+		{
+			name:      "Invalid vector count",
+			arguments: `vector_count = 0`,
+			err:       `(?s)vector_count = 0.*vector_count\s+must\s+be\s+at\s+least\s+1`,
+		},
+		{
+			name:      "Invalid dimension",
+			arguments: `dimension = 0`,
+			err:       `(?s)dimension = 0.*dimension\s+must\s+be\s+at\s+least\s+1`,
+		},
+		{
+			name:      "Negative metadata bytes",
+			arguments: `metadata_bytes_per_vector = -1`,
+			err:       `(?s)metadata_bytes_per_vector = -1.*metadata_bytes_per_vector\s+must\s+not\s+be\s+negative`,
+		},
+		{
+			name:      "Negative target QPS",
+			arguments: `target_qps = -5`,
+			err:       `(?s)target_qps = -5.*target_qps\s+must\s+not\s+be\s+negative`,
+		},
+		{
+			name:      "Unknown pod class",
+			arguments: `pod_class = "s9"`,
+			err:       `(?s)pod_class = "s9".*pod_class\s+must\s+be\s+s1,\s+p1\s+or\s+p2`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			arguments := map[string]string{"vector_count": "vector_count = 1000", "dimension": "dimension = 768"}
+			arguments[strings.Fields(tc.arguments)[0]] = tc.arguments
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+data "pinecone_capacity_plan" "test" {
+    ` + strings.Join(slices.Sorted(maps.Values(arguments)), "\n    ") + `
+}
+`,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
@@ -1,18 +1,28 @@
 package pinecone
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// DefaultControlPlaneBaseURL is the base URL of the global control plane, which serves
+// backups, restore jobs, inference and assistants of a project across environments.
+const DefaultControlPlaneBaseURL = "https://api.pinecone.io"
+
+// controlPlaneAPIVersion is the version of the global control plane and assistant APIs this client speaks.
+const controlPlaneAPIVersion = "2025-04"
+
 var (
 	ErrEmptyAPIKey      = errors.New("error: api key is empty")
 	ErrEmptyEnvironment = errors.New("error: environment is empty")
@@ -28,14 +38,123 @@ type PineconeClientInterface interface {
 	GetBaseURL() string
 	ListIndexes(ctx context.Context) ([]string, error)
 	CreateIndex(ctx context.Context, req CreateIndexRequest) error
+	CreateIndexForModel(ctx context.Context, req CreateIndexForModelRequest) error
 	DescribeIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error)
+	// DescribeServerlessIndex describes an index through the control plane, which created the
+	// serverless indexes of CreateIndexForModel. It returns nil if the index does not exist.
+	DescribeServerlessIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error)
 	DeleteIndex(ctx context.Context, indexName string) error
 	ConfigureIndex(ctx context.Context, indexName string, req ConfigureIndexRequest) error
+	ListBackups(ctx context.Context) ([]Backup, error)
+	CreateBackup(ctx context.Context, indexName string, req CreateBackupRequest) (*Backup, error)
+	// DescribeBackup returns nil if the backup does not exist.
+	DescribeBackup(ctx context.Context, backupID string) (*Backup, error)
+	DeleteBackup(ctx context.Context, backupID string) error
+	CreateIndexFromBackup(ctx context.Context, backupID string, req CreateIndexFromBackupRequest) (*CreateIndexFromBackupResponse, error)
+	ListRestoreJobs(ctx context.Context) ([]RestoreJob, error)
+	// DescribeRestoreJob returns nil if the restore job does not exist.
+	DescribeRestoreJob(ctx context.Context, jobID string) (*RestoreJob, error)
+	Embed(ctx context.Context, req EmbedRequest) (*EmbedResponse, error)
+	ListModels(ctx context.Context) ([]ModelInfo, error)
+	CreateAssistant(ctx context.Context, req CreateAssistantRequest) (*Assistant, error)
+	// DescribeAssistant returns nil if the assistant does not exist.
+	DescribeAssistant(ctx context.Context, name string) (*Assistant, error)
+	UpdateAssistant(ctx context.Context, name string, req UpdateAssistantRequest) (*Assistant, error)
+	DeleteAssistant(ctx context.Context, name string) error
+	UploadAssistantFile(ctx context.Context, assistantName string, fileName string, content io.Reader, metadata map[string]any) (*AssistantFile, error)
+	// DescribeAssistantFile returns nil if the file does not exist.
+	DescribeAssistantFile(ctx context.Context, assistantName string, fileID string) (*AssistantFile, error)
+	DeleteAssistantFile(ctx context.Context, assistantName string, fileID string) error
+	DataPlaneClientInterface
 }
 
 type PineconeClient struct {
 	APIKey      string
 	Environment string
+	// Tokens, if set, authenticates requests with OAuth bearer tokens instead of APIKey.
+	Tokens *TokenSource
+	// HTTPClient sends every request, to the controller and to index hosts. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// ControlPlaneBaseURL defaults to DefaultControlPlaneBaseURL.
+	ControlPlaneBaseURL string
+
+	dataPlane     *DataPlaneClient
+	dataPlaneOnce sync.Once
+}
+
+func (c *PineconeClient) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+// dataPlaneClient returns the client of index hosts, created on first use so that
+// a PineconeClient built as a literal shares its credentials and http.Client too.
+func (c *PineconeClient) dataPlaneClient() *DataPlaneClient {
+	c.dataPlaneOnce.Do(func() {
+		c.dataPlane = NewDataPlaneClient(c, c.APIKey, nil)
+		c.dataPlane.httpClient = c.httpClient
+		c.dataPlane.tokens = c.Tokens
+	})
+	return c.dataPlane
+}
+
+// do sends a request to the global control plane and decodes the JSON response into resp, if not nil.
+// It returns the status code so callers can tell a missing resource from a failure.
+func (c *PineconeClient) do(ctx context.Context, method string, path string, body any, resp any) (int, error) {
+	baseURL := c.ControlPlaneBaseURL
+	if baseURL == "" {
+		baseURL = DefaultControlPlaneBaseURL
+	}
+
+	var payload io.Reader
+	contentType := ""
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		payload = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+	return c.send(ctx, method, baseURL+path, contentType, payload, resp)
+}
+
+// send sends a versioned request with the credentials of the client and decodes the JSON
+// response into resp, if not nil. It returns the status code like do.
+func (c *PineconeClient) send(ctx context.Context, method string, url string, contentType string, payload io.Reader, resp any) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, payload)
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Add("accept", "application/json")
+	if contentType != "" {
+		httpReq.Header.Add("content-type", contentType)
+	}
+	if err := setAuthHeader(ctx, httpReq, c.APIKey, c.Tokens); err != nil {
+		return 0, err
+	}
+	httpReq.Header.Add("X-Pinecone-Api-Version", controlPlaneAPIVersion)
+
+	res, err := c.httpClient().Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return res.StatusCode, err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		return res.StatusCode, fmt.Errorf("error: %s %s status code: %d: %s", method, httpReq.URL.Path, res.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+
+	if resp == nil || len(resBody) == 0 {
+		return res.StatusCode, nil
+	}
+	return res.StatusCode, json.Unmarshal(resBody, resp)
 }
 
 func (c *PineconeClient) GetAPIKey() string {
@@ -73,6 +192,15 @@ func NewClient(apiKey string, environment string) (*PineconeClient, error) {
 	}, nil
 }
 
+// NewClientWithTokens creates a client that authenticates every request, to the
+// controller and to index hosts, with bearer tokens from tokens.
+func NewClientWithTokens(tokens *TokenSource, environment string) (*PineconeClient, error) {
+	return &PineconeClient{
+		Environment: environment,
+		Tokens:      tokens,
+	}, nil
+}
+
 type ListIndexesResponse []string
 
 // GetBaseURL get base url
@@ -95,9 +223,11 @@ func (c *PineconeClient) ListIndexes(ctx context.Context) ([]string, error) {
 		return nil, err
 	}
 	req.Header.Add("accept", "application/json; charset=utf-8")
-	req.Header.Add("Api-Key", c.APIKey)
+	if err := setAuthHeader(ctx, req, c.APIKey, c.Tokens); err != nil {
+		return nil, err
+	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.httpClient().Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -119,20 +249,27 @@ func (c *PineconeClient) ListIndexes(ctx context.Context) ([]string, error) {
 	return resp, nil
 }
 
-type Metric int
+// Metric is the distance metric of an index. Values returned by Pinecone that
+// this provider does not know yet are kept verbatim, see IsKnown.
+type Metric string
 
 const (
-	MetricEuclidean Metric = iota
-	MetricCosine
-	MetricDotProduct
+	MetricEuclidean  Metric = "euclidean"
+	MetricCosine     Metric = "cosine"
+	MetricDotProduct Metric = "dotproduct"
 )
 
 func (m Metric) String() string {
-	metricTypes := [...]string{"euclidean", "cosine", "dotproduct"}
-	if m < 0 || int(m) >= len(metricTypes) {
+	if m == "" {
 		return "cosine" // default value
 	}
-	return metricTypes[m]
+	return string(m)
+}
+
+// IsKnown reports whether the metric is one this provider recognizes.
+func (m Metric) IsKnown() bool {
+	_, err := NewMetric(string(m))
+	return err == nil
 }
 
 func NewMetric(metricStr string) (Metric, error) {
@@ -149,38 +286,56 @@ func NewMetric(metricStr string) (Metric, error) {
 }
 
 func (m Metric) MarshalJSON() ([]byte, error) {
-	return []byte(`"` + m.String() + `"`), nil
+	return json.Marshal(m.String())
 }
 
+// UnmarshalJSON accepts any metric so that indexes using a metric introduced
+// after this provider was released can still be read.
 func (m *Metric) UnmarshalJSON(data []byte) error {
 	var metricStr string
 	if err := json.Unmarshal(data, &metricStr); err != nil {
 		return err
 	}
 
-	switch metricStr {
-	case "euclidean":
-		*m = MetricEuclidean
-	case "cosine":
-		*m = MetricCosine
-	case "dotproduct":
-		*m = MetricDotProduct
-	default:
-		return fmt.Errorf("error: invalid metric value: %s", metricStr)
-	}
-
+	*m = Metric(metricStr)
 	return nil
 }
 
 type PodType struct {
 	Class string
 	Size  string
+
+	// raw keeps a pod type reported by Pinecone that does not split into class and size.
+	raw string
 }
 
 func (p PodType) String() string {
+	if p.Class == "" && p.Size == "" {
+		return p.raw
+	}
 	return p.Class + "." + p.Size
 }
 
+// IsKnown reports whether the pod type is one this provider recognizes.
+func (p PodType) IsKnown() bool {
+	_, err := NewPodType(p.String())
+	return err == nil
+}
+
+// ParsePodType parses a pod type without validating it, keeping values this
+// provider does not recognize verbatim. Use NewPodType to validate user input.
+func ParsePodType(podTypeStr string) PodType {
+	if podType, err := NewPodType(podTypeStr); err == nil {
+		return podType
+	}
+
+	splitPodType := strings.Split(podTypeStr, ".")
+	if len(splitPodType) == 2 && splitPodType[0] != "" && splitPodType[1] != "" {
+		return PodType{Class: splitPodType[0], Size: splitPodType[1]}
+	}
+	return PodType{raw: podTypeStr}
+}
+
 // NewPodType creates a new PodType
 func NewPodType(podTypeStr string) (PodType, error) {
 	splitPodType := strings.Split(podTypeStr, ".")
@@ -202,25 +357,55 @@ func NewPodType(podTypeStr string) (PodType, error) {
 	return PodType{Class: class, Size: size}, nil
 }
 
+// ScaleUp returns the pod type of the next size up in the same class.
+func (p PodType) ScaleUp() (PodType, error) {
+	for i, size := range podSizes {
+		if size != p.Size {
+			continue
+		}
+		if i == len(podSizes)-1 {
+			return PodType{}, fmt.Errorf("error: pod type %s is already the largest size", p)
+		}
+		return PodType{Class: p.Class, Size: podSizes[i+1]}, nil
+	}
+	return PodType{}, fmt.Errorf("error: invalid pod size: %s", p.Size)
+}
+
 func (p PodType) MarshalJSON() ([]byte, error) {
-	return []byte(`"` + p.String() + `"`), nil
+	return json.Marshal(p.String())
 }
 
+// UnmarshalJSON accepts any pod type so that indexes using a pod type introduced
+// after this provider was released can still be read.
 func (p *PodType) UnmarshalJSON(data []byte) error {
 	var podTypeStr string
 	if err := json.Unmarshal(data, &podTypeStr); err != nil {
 		return err
 	}
 
-	podType, err := NewPodType(podTypeStr)
-	if err != nil {
-		return err
-	}
-
-	*p = podType
+	*p = ParsePodType(podTypeStr)
 	return nil
 }
 
+// MaxIndexNameLength is the longest index name Pinecone accepts.
+const MaxIndexNameLength = 45
+
+var invalidIndexNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// NormalizeIndexName turns an arbitrary string into a valid index name: lowercase
+// alphanumerics separated by single hyphens, at most MaxIndexNameLength characters.
+func NormalizeIndexName(name string) (string, error) {
+	normalized := invalidIndexNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	normalized = strings.Trim(normalized, "-")
+	if len(normalized) > MaxIndexNameLength {
+		normalized = strings.TrimRight(normalized[:MaxIndexNameLength], "-")
+	}
+	if normalized == "" {
+		return "", fmt.Errorf("error: index name %q has no alphanumeric characters", name)
+	}
+	return normalized, nil
+}
+
 // Nested struct that supports the format {"indexed": ["example_metadata_field"]}
 type MetadataConfig struct {
 	Indexed []string `json:"indexed"`
@@ -234,8 +419,11 @@ func NewMetadataConfig(receivedMetadataConfig types.Object) (*MetadataConfig, er
 	var indexed []string
 	values := receivedMetadataConfig.Attributes()["indexed"]
 
-	listValues := values.(basetypes.ListValue)
-	for _, val := range listValues.Elements() {
+	setValues, ok := values.(basetypes.SetValue)
+	if !ok {
+		return nil, fmt.Errorf("error: invalid type for indexed")
+	}
+	for _, val := range setValues.Elements() {
 		str, ok := val.(basetypes.StringValue)
 		if !ok {
 			return nil, fmt.Errorf("error: invalid type for indexed element")
@@ -249,6 +437,26 @@ func NewMetadataConfig(receivedMetadataConfig types.Object) (*MetadataConfig, er
 	}, nil
 }
 
+// Equal reports whether both configs index the same fields, in any order.
+func (m *MetadataConfig) Equal(other *MetadataConfig) bool {
+	if m == nil || other == nil {
+		return m == nil && other == nil
+	}
+
+	fields := make(map[string]bool, len(m.Indexed))
+	for _, field := range m.Indexed {
+		fields[field] = true
+	}
+	otherFields := make(map[string]bool, len(other.Indexed))
+	for _, field := range other.Indexed {
+		if !fields[field] {
+			return false
+		}
+		otherFields[field] = true
+	}
+	return len(fields) == len(otherFields)
+}
+
 type CreateIndexRequest struct {
 	Name           string          `json:"name"` // The name of the index to be created. The maximum length is 45 characters.
 	Dimension      int             `json:"dimension"`
@@ -259,6 +467,38 @@ type CreateIndexRequest struct {
 	MetadataConfig *MetadataConfig `json:"metadata_config,omitempty"`
 }
 
+// IndexEmbed is the integrated inference config of an index.
+type IndexEmbed struct {
+	Model string `json:"model"`
+	// FieldMap maps the input of the model, "text", to the record field holding the text to embed.
+	FieldMap        map[string]string `json:"field_map"`
+	ReadParameters  map[string]any    `json:"read_parameters,omitempty"`
+	WriteParameters map[string]any    `json:"write_parameters,omitempty"`
+}
+
+// CreateIndexForModelRequest creates a serverless index tied to a hosted embedding model,
+// so that Pinecone embeds the text of records.
+type CreateIndexForModelRequest struct {
+	Name   string             `json:"name"`
+	Cloud  string             `json:"cloud"`  // aws, gcp or azure
+	Region string             `json:"region"` // e.g. us-east-1
+	Embed  IndexForModelEmbed `json:"embed"`
+}
+
+// IndexForModelEmbed is the integrated inference config of a new index, with the
+// metric and dimension of its vectors. Both default to the model's.
+type IndexForModelEmbed struct {
+	IndexEmbed
+	Metric    Metric `json:"metric,omitempty"`
+	Dimension int    `json:"dimension,omitempty"`
+}
+
+// ServerlessSpec is where a serverless index runs.
+type ServerlessSpec struct {
+	Cloud  string `json:"cloud"`
+	Region string `json:"region"`
+}
+
 // CreateIndex creates an index
 func (c *PineconeClient) CreateIndex(ctx context.Context, req CreateIndexRequest) error {
 	baseURL := c.GetBaseURL()
@@ -280,8 +520,10 @@ func (c *PineconeClient) CreateIndex(ctx context.Context, req CreateIndexRequest
 	}
 	httpReq.Header.Add("accept", "text/plain; charset=utf-8")
 	httpReq.Header.Add("content-type", "application/json")
-	httpReq.Header.Add("Api-Key", c.APIKey)
-	res, err := http.DefaultClient.Do(httpReq)
+	if err := setAuthHeader(ctx, httpReq, c.APIKey, c.Tokens); err != nil {
+		return err
+	}
+	res, err := c.httpClient().Do(httpReq)
 	if err != nil {
 		return err
 	}
@@ -308,6 +550,9 @@ type DescribeDatabaseResponse struct {
 	Pods           int             `json:"pods"`
 	PodType        PodType         `json:"pod_type"`
 	MetadataConfig *MetadataConfig `json:"metadata_config,omitempty"`
+	Embed          *IndexEmbed     `json:"embed,omitempty"`
+	// Serverless is set for serverless indexes, e.g. those created for a model.
+	Serverless *ServerlessSpec `json:"serverless,omitempty"`
 }
 
 type DescribeStatusResponse struct {
@@ -331,8 +576,10 @@ func (c *PineconeClient) DescribeIndex(ctx context.Context, indexName string) (*
 		return nil, err
 	}
 	httpReq.Header.Add("accept", "application/json")
-	httpReq.Header.Add("Api-Key", c.APIKey)
-	res, err := http.DefaultClient.Do(httpReq)
+	if err := setAuthHeader(ctx, httpReq, c.APIKey, c.Tokens); err != nil {
+		return nil, err
+	}
+	res, err := c.httpClient().Do(httpReq)
 	if err != nil {
 		return nil, err
 	}
@@ -369,8 +616,10 @@ func (c *PineconeClient) DeleteIndex(ctx context.Context, indexName string) erro
 		return err
 	}
 	httpReq.Header.Add("accept", "text/plain")
-	httpReq.Header.Add("Api-Key", c.APIKey)
-	res, err := http.DefaultClient.Do(httpReq)
+	if err := setAuthHeader(ctx, httpReq, c.APIKey, c.Tokens); err != nil {
+		return err
+	}
+	res, err := c.httpClient().Do(httpReq)
 	if err != nil {
 		return err
 	}
@@ -378,12 +627,19 @@ func (c *PineconeClient) DeleteIndex(ctx context.Context, indexName string) erro
 		return fmt.Errorf("error: status code is greater than or equal to %d: %d", http.StatusBadRequest, res.StatusCode)
 	}
 	defer res.Body.Close()
+
+	// A new index with the same name gets a new host.
+	c.dataPlaneClient().Forget(indexName)
 	return nil
 }
 
 type ConfigureIndexRequest struct {
-	Replicas int     `json:"replicas"`
-	PodType  PodType `json:"pod_type"`
+	// Replicas and PodType are only set for pod indexes; serverless indexes have neither.
+	Replicas *int     `json:"replicas,omitempty"`
+	PodType  *PodType `json:"pod_type,omitempty"`
+	// Embed changes the read and write parameters of an index with integrated inference.
+	// The model and field map cannot be changed.
+	Embed *IndexEmbed `json:"embed,omitempty"`
 }
 
 // ConfigureIndex configures an index
@@ -406,8 +662,10 @@ func (c *PineconeClient) ConfigureIndex(ctx context.Context, indexName string, r
 	}
 	httpReq.Header.Add("accept", "text/plain")
 	httpReq.Header.Add("content-type", "application/json")
-	httpReq.Header.Add("Api-Key", c.APIKey)
-	res, err := http.DefaultClient.Do(httpReq)
+	if err := setAuthHeader(ctx, httpReq, c.APIKey, c.Tokens); err != nil {
+		return err
+	}
+	res, err := c.httpClient().Do(httpReq)
 	if err != nil {
 		return err
 	}
@@ -417,3 +675,33 @@ func (c *PineconeClient) ConfigureIndex(ctx context.Context, indexName string, r
 	defer res.Body.Close()
 	return nil
 }
+
+// DescribeIndexStats returns vector counts per namespace of an index
+func (c *PineconeClient) DescribeIndexStats(ctx context.Context, indexName string, req DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error) {
+	return c.dataPlaneClient().DescribeIndexStats(ctx, indexName, req)
+}
+
+// Upsert writes vectors to an index
+func (c *PineconeClient) Upsert(ctx context.Context, indexName string, req UpsertRequest) (*UpsertResponse, error) {
+	return c.dataPlaneClient().Upsert(ctx, indexName, req)
+}
+
+// Fetch reads vectors of an index by ID
+func (c *PineconeClient) Fetch(ctx context.Context, indexName string, req FetchRequest) (*FetchResponse, error) {
+	return c.dataPlaneClient().Fetch(ctx, indexName, req)
+}
+
+// Query queries an index
+func (c *PineconeClient) Query(ctx context.Context, indexName string, req QueryRequest) (*QueryResponse, error) {
+	return c.dataPlaneClient().Query(ctx, indexName, req)
+}
+
+// Delete deletes vectors of an index
+func (c *PineconeClient) Delete(ctx context.Context, indexName string, req DeleteRequest) error {
+	return c.dataPlaneClient().Delete(ctx, indexName, req)
+}
+
+// List lists vector IDs of an index
+func (c *PineconeClient) List(ctx context.Context, indexName string, req ListRequest) (*ListResponse, error) {
+	return c.dataPlaneClient().List(ctx, indexName, req)
+}
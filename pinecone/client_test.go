@@ -1,8 +1,10 @@
 package pinecone
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -146,7 +148,9 @@ func TestUnmarshalJSON(t *testing.T) {
 		{jsonStr: `"euclidean"`, expected: MetricEuclidean, err: nil},
 		{jsonStr: `"cosine"`, expected: MetricCosine, err: nil},
 		{jsonStr: `"dotproduct"`, expected: MetricDotProduct, err: nil},
-		{jsonStr: `"manhattan"`, expected: MetricEuclidean, err: fmt.Errorf("error: invalid metric value: manhattan")},
+		// Metrics introduced after this provider was released are kept verbatim.
+		{jsonStr: `"manhattan"`, expected: Metric("manhattan"), err: nil},
+		{jsonStr: `1`, expected: Metric(""), err: fmt.Errorf("json: cannot unmarshal number into Go value of type string")},
 	}
 
 	for _, tC := range testCases {
@@ -157,3 +161,109 @@ func TestUnmarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestPodTypeScaleUp(t *testing.T) {
+	testCases := []struct {
+		input    PodType
+		expected PodType
+		err      error
+	}{
+		{input: PodType{Class: "p1", Size: "x1"}, expected: PodType{Class: "p1", Size: "x2"}},
+		{input: PodType{Class: "s1", Size: "x4"}, expected: PodType{Class: "s1", Size: "x8"}},
+		{input: PodType{Class: "p2", Size: "x8"}, err: fmt.Errorf("error: pod type p2.x8 is already the largest size")},
+	}
+
+	for _, tC := range testCases {
+		actual, err := tC.input.ScaleUp()
+		if actual != tC.expected || (err == nil && tC.err != nil) || (err != nil && tC.err == nil) {
+			t.Errorf("ScaleUp(%v) = (%v, %v), expected (%v, %v)", tC.input, actual, err, tC.expected, tC.err)
+		}
+	}
+}
+
+func TestNormalizeIndexName(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{input: "my-index", expected: "my-index"},
+		{input: "My Team's Index_v2", expected: "my-team-s-index-v2"},
+		{input: "--Prod__Search--", expected: "prod-search"},
+		{input: strings.Repeat("a", 44) + "-b", expected: strings.Repeat("a", 44)},
+		{input: "___", err: fmt.Errorf("error: index name \"___\" has no alphanumeric characters")},
+	}
+
+	for _, tC := range testCases {
+		actual, err := NormalizeIndexName(tC.input)
+		if actual != tC.expected || (err == nil && tC.err != nil) || (err != nil && tC.err == nil) {
+			t.Errorf("NormalizeIndexName(%q) = (%v, %v), expected (%v, %v)", tC.input, actual, err, tC.expected, tC.err)
+		}
+	}
+}
+
+func TestPodTypeJSONRoundTrip(t *testing.T) {
+	testCases := []struct {
+		jsonStr  string
+		expected PodType
+		known    bool
+	}{
+		{jsonStr: `"p1.x2"`, expected: PodType{Class: "p1", Size: "x2"}, known: true},
+		{jsonStr: `"p3.x1"`, expected: PodType{Class: "p3", Size: "x1"}, known: false},
+		{jsonStr: `"s1.x16"`, expected: PodType{Class: "s1", Size: "x16"}, known: false},
+		{jsonStr: `"starter"`, expected: PodType{raw: "starter"}, known: false},
+		{jsonStr: `""`, expected: PodType{}, known: false},
+	}
+
+	for _, tC := range testCases {
+		var actual PodType
+		if err := json.Unmarshal([]byte(tC.jsonStr), &actual); err != nil {
+			t.Fatalf("Unmarshal(%s) failed: %v", tC.jsonStr, err)
+		}
+		if actual != tC.expected || actual.IsKnown() != tC.known {
+			t.Errorf("Unmarshal(%s) = (%#v, known %v), expected (%#v, known %v)", tC.jsonStr, actual, actual.IsKnown(), tC.expected, tC.known)
+		}
+
+		data, err := json.Marshal(actual)
+		if err != nil || string(data) != tC.jsonStr {
+			t.Errorf("Marshal(%#v) = (%s, %v), expected %s", actual, data, err, tC.jsonStr)
+		}
+	}
+}
+
+func TestMetricJSONRoundTrip(t *testing.T) {
+	for _, jsonStr := range []string{`"cosine"`, `"hamming"`} {
+		var metric Metric
+		if err := json.Unmarshal([]byte(jsonStr), &metric); err != nil {
+			t.Fatalf("Unmarshal(%s) failed: %v", jsonStr, err)
+		}
+		data, err := json.Marshal(metric)
+		if err != nil || string(data) != jsonStr {
+			t.Errorf("Marshal(%v) = (%s, %v), expected %s", metric, data, err, jsonStr)
+		}
+	}
+}
+
+func TestMetadataConfigEqual(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        *MetadataConfig
+		b        *MetadataConfig
+		expected bool
+	}{
+		{name: "Both nil", a: nil, b: nil, expected: true},
+		{name: "One nil", a: &MetadataConfig{}, b: nil, expected: false},
+		{name: "Same order", a: &MetadataConfig{Indexed: []string{"a", "b"}}, b: &MetadataConfig{Indexed: []string{"a", "b"}}, expected: true},
+		{name: "Different order", a: &MetadataConfig{Indexed: []string{"a", "b"}}, b: &MetadataConfig{Indexed: []string{"b", "a"}}, expected: true},
+		{name: "Different fields", a: &MetadataConfig{Indexed: []string{"a", "b"}}, b: &MetadataConfig{Indexed: []string{"a", "c"}}, expected: false},
+		{name: "Subset", a: &MetadataConfig{Indexed: []string{"a", "b"}}, b: &MetadataConfig{Indexed: []string{"a"}}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.a.Equal(tc.b); actual != tc.expected {
+				t.Fatalf("test '%s' failed: expected %v, but received %v", tc.name, tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// copyVerifyAttempts and copyVerifyInterval bound how long VerifyCopy waits
+	// for the counts of the destination to catch up, as index stats lag upserts.
+	copyVerifyAttempts = 6
+	copyVerifyInterval = 5 * time.Second
+)
+
+// CopyRequest describes the indexes and namespaces to copy between.
+type CopyRequest struct {
+	SourceIndexName      string
+	DestinationIndexName string
+	// Namespaces to copy; all namespaces of the source index if empty.
+	Namespaces  []string
+	BatchSize   int
+	Parallelism int
+}
+
+// CopyProgress is how far the copy of a namespace got.
+type CopyProgress struct {
+	Copied int
+	// NextToken is the pagination token of the source to resume at.
+	NextToken string
+	Done      bool
+}
+
+// copyNamespaces returns the namespaces of the request, listing those of the source if none are given.
+func copyNamespaces(ctx context.Context, source PineconeClientInterface, req CopyRequest) ([]string, error) {
+	if len(req.Namespaces) > 0 {
+		return req.Namespaces, nil
+	}
+	stats, err := source.DescribeIndexStats(ctx, req.SourceIndexName, DescribeIndexStatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return sortedKeys(stats.Namespaces), nil
+}
+
+// CopyIndex streams the vectors of each namespace from the source index to the
+// destination index, skipping namespaces progress marks done and resuming the
+// others at their token. progress is updated after every window of vectors, so
+// on error it records where to resume.
+func CopyIndex(ctx context.Context, source PineconeClientInterface, destination PineconeClientInterface, req CopyRequest, progress map[string]*CopyProgress) ([]string, error) {
+	sourceIndex, err := source.DescribeIndex(ctx, req.SourceIndexName)
+	if err != nil {
+		return nil, err
+	}
+	if sourceIndex == nil {
+		return nil, fmt.Errorf("error: source index %s not found", req.SourceIndexName)
+	}
+	destinationIndex, err := destination.DescribeIndex(ctx, req.DestinationIndexName)
+	if err != nil {
+		return nil, err
+	}
+	if destinationIndex == nil {
+		return nil, fmt.Errorf("error: destination index %s not found", req.DestinationIndexName)
+	}
+	if sourceIndex.Database.Dimension != destinationIndex.Database.Dimension {
+		return nil, fmt.Errorf("error: source index %s has dimension %d, but destination index %s has dimension %d",
+			req.SourceIndexName, sourceIndex.Database.Dimension, req.DestinationIndexName, destinationIndex.Database.Dimension)
+	}
+
+	namespaces, err := copyNamespaces(ctx, source, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, namespace := range namespaces {
+		p, ok := progress[namespace]
+		if !ok {
+			p = &CopyProgress{}
+			progress[namespace] = p
+		}
+		if p.Done {
+			continue
+		}
+
+		err := streamVectors(ctx, source, req.SourceIndexName, namespace, p.NextToken, req.BatchSize, req.Parallelism, func(vectors []Vector, next string) error {
+			if err := upsertVectors(ctx, destination, req.DestinationIndexName, namespace, vectors, req.BatchSize, req.Parallelism); err != nil {
+				return err
+			}
+			p.Copied += len(vectors)
+			p.NextToken = next
+			p.Done = next == ""
+			return nil
+		})
+		if err != nil {
+			return namespaces, fmt.Errorf("error: copy namespace %q after %d vectors: %w", namespace, p.Copied, err)
+		}
+	}
+	return namespaces, nil
+}
+
+// VerifyCopy checks that each namespace holds as many vectors in the destination as in the source.
+func VerifyCopy(ctx context.Context, source PineconeClientInterface, destination PineconeClientInterface, req CopyRequest, namespaces []string) error {
+	var mismatches []string
+	for attempt := 0; attempt < copyVerifyAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(copyVerifyInterval):
+			}
+		}
+
+		sourceStats, err := source.DescribeIndexStats(ctx, req.SourceIndexName, DescribeIndexStatsRequest{})
+		if err != nil {
+			return err
+		}
+		destinationStats, err := destination.DescribeIndexStats(ctx, req.DestinationIndexName, DescribeIndexStatsRequest{})
+		if err != nil {
+			return err
+		}
+
+		mismatches = nil
+		for _, namespace := range namespaces {
+			want := sourceStats.Namespaces[namespace].VectorCount
+			got := destinationStats.Namespaces[namespace].VectorCount
+			if want != got {
+				mismatches = append(mismatches, fmt.Sprintf("namespace %q has %d vectors in %s but %d in %s",
+					namespace, want, req.SourceIndexName, got, req.DestinationIndexName))
+			}
+		}
+		if len(mismatches) == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("error: copy verification failed: %s", strings.Join(mismatches, "; "))
+}
@@ -0,0 +1,94 @@
+package pinecone
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// failingUpsertClient fails every upsert after the first allowed ones.
+type failingUpsertClient struct {
+	*MockPineconeClient
+
+	allowed int
+}
+
+func (c *failingUpsertClient) Upsert(ctx context.Context, indexName string, req UpsertRequest) (*UpsertResponse, error) {
+	if c.allowed <= 0 {
+		return nil, errors.New("error: upsert unavailable")
+	}
+	c.allowed--
+	return c.MockPineconeClient.Upsert(ctx, indexName, req)
+}
+
+func TestCopyIndex(t *testing.T) {
+	ctx := context.Background()
+	source := newSeededMockClient(t, 250, "", "tenant-a")
+	destination := newSeededMockClient(t, 0)
+	req := CopyRequest{SourceIndexName: "test", DestinationIndexName: "test", BatchSize: 50, Parallelism: 1}
+
+	// Stop after the first window of 50 vectors.
+	flaky := &failingUpsertClient{MockPineconeClient: destination, allowed: 1}
+	progress := make(map[string]*CopyProgress)
+	_, err := CopyIndex(ctx, source, flaky, req, progress)
+	if err == nil || !strings.Contains(err.Error(), `copy namespace "" after 50 vectors`) {
+		t.Fatalf("expected the copy to stop after 50 vectors, but received %v", err)
+	}
+	if p := progress[""]; p.Copied != 50 || p.NextToken == "" || p.Done {
+		t.Fatalf("unexpected checkpoint: %+v", p)
+	}
+
+	// Resume from the checkpoint.
+	flaky.allowed = 100
+	namespaces, err := CopyIndex(ctx, source, flaky, req, progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, namespace := range []string{"", "tenant-a"} {
+		if p := progress[namespace]; p.Copied != 250 || p.NextToken != "" || !p.Done {
+			t.Fatalf("unexpected checkpoint of %q: %+v", namespace, p)
+		}
+	}
+	// 4 windows for the rest of "" and 5 for tenant-a.
+	if flaky.allowed != 91 {
+		t.Fatalf("expected 9 upserts after resuming, but received %d", 100-flaky.allowed)
+	}
+	if err := VerifyCopy(ctx, source, destination, req, namespaces); err != nil {
+		t.Fatal(err)
+	}
+
+	// A done copy does nothing.
+	flaky.allowed = 0
+	if _, err := CopyIndex(ctx, source, flaky, req, progress); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCopyIndexErrors(t *testing.T) {
+	ctx := context.Background()
+	source := newSeededMockClient(t, 3, "")
+	destination := newSeededMockClient(t, 0)
+	_ = destination.CreateIndex(ctx, CreateIndexRequest{Name: "wide", Dimension: 3})
+	req := CopyRequest{SourceIndexName: "test", DestinationIndexName: "wide", BatchSize: 2, Parallelism: 2}
+
+	_, err := CopyIndex(ctx, source, destination, req, make(map[string]*CopyProgress))
+	if err == nil || !strings.Contains(err.Error(), "destination index wide has dimension 3") {
+		t.Fatalf("expected a dimension mismatch, but received %v", err)
+	}
+
+	req.DestinationIndexName = "missing"
+	if _, err := CopyIndex(ctx, source, destination, req, make(map[string]*CopyProgress)); err == nil {
+		t.Fatal("expected an error for a missing destination")
+	}
+
+	attempts := copyVerifyAttempts
+	copyVerifyAttempts = 1
+	defer func() { copyVerifyAttempts = attempts }()
+
+	req.DestinationIndexName = "test"
+	err = VerifyCopy(ctx, source, destination, req, []string{""})
+	if err == nil || !strings.Contains(err.Error(), `namespace "" has 3 vectors in test but 0 in test`) {
+		t.Fatalf("expected a count mismatch, but received %v", err)
+	}
+}
@@ -0,0 +1,347 @@
+package pinecone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	_ DataPlaneClientInterface = &DataPlaneClient{}
+)
+
+// DataPlaneClientInterface is the API served by the host of each index, as
+// opposed to the controller API that manages the indexes themselves.
+type DataPlaneClientInterface interface {
+	DescribeIndexStats(ctx context.Context, indexName string, req DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error)
+	Upsert(ctx context.Context, indexName string, req UpsertRequest) (*UpsertResponse, error)
+	Fetch(ctx context.Context, indexName string, req FetchRequest) (*FetchResponse, error)
+	Query(ctx context.Context, indexName string, req QueryRequest) (*QueryResponse, error)
+	Delete(ctx context.Context, indexName string, req DeleteRequest) error
+	List(ctx context.Context, indexName string, req ListRequest) (*ListResponse, error)
+}
+
+type Vector struct {
+	ID           string         `json:"id"`
+	Values       []float32      `json:"values,omitempty"`
+	SparseValues *SparseValues  `json:"sparseValues,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+}
+
+// SparseValues holds the non-zero entries of a sparse vector. Pinecone stores
+// and queries sparse values only in indexes with the dotproduct metric.
+type SparseValues struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+// Validate checks that every index has a value and appears once.
+func (s *SparseValues) Validate() error {
+	if len(s.Indices) != len(s.Values) {
+		return fmt.Errorf("error: sparse values have %d indices but %d values", len(s.Indices), len(s.Values))
+	}
+	seen := make(map[uint32]bool, len(s.Indices))
+	for _, i := range s.Indices {
+		if seen[i] {
+			return fmt.Errorf("error: sparse values have duplicate index %d", i)
+		}
+		seen[i] = true
+	}
+	return nil
+}
+
+// checkSparseMetric returns an error when an index with the metric cannot hold sparse values.
+func checkSparseMetric(indexName string, metric Metric) error {
+	if metric != MetricDotProduct {
+		return fmt.Errorf("error: index %s has metric %s, but sparse values require %s", indexName, metric, MetricDotProduct)
+	}
+	return nil
+}
+
+type DescribeIndexStatsRequest struct {
+	Filter map[string]any `json:"filter,omitempty"`
+}
+
+type DescribeIndexStatsResponse struct {
+	Namespaces       map[string]NamespaceSummary `json:"namespaces"`
+	Dimension        int                         `json:"dimension"`
+	IndexFullness    float64                     `json:"indexFullness"`
+	TotalVectorCount int                         `json:"totalVectorCount"`
+}
+
+type NamespaceSummary struct {
+	VectorCount int `json:"vectorCount"`
+}
+
+type UpsertRequest struct {
+	Vectors   []Vector `json:"vectors"`
+	Namespace string   `json:"namespace,omitempty"`
+}
+
+type UpsertResponse struct {
+	UpsertedCount int `json:"upsertedCount"`
+}
+
+type FetchRequest struct {
+	IDs       []string
+	Namespace string
+}
+
+type FetchResponse struct {
+	Vectors   map[string]Vector `json:"vectors"`
+	Namespace string            `json:"namespace"`
+}
+
+type QueryRequest struct {
+	Namespace       string         `json:"namespace,omitempty"`
+	TopK            int            `json:"topK"`
+	Filter          map[string]any `json:"filter,omitempty"`
+	IncludeValues   bool           `json:"includeValues"`
+	IncludeMetadata bool           `json:"includeMetadata"`
+	// Exactly one of Vector and ID is set: query by values or by the values of a stored vector.
+	Vector []float32 `json:"vector,omitempty"`
+	ID     string    `json:"id,omitempty"`
+	// SparseVector adds a sparse part to Vector for hybrid queries of dotproduct indexes.
+	SparseVector *SparseValues `json:"sparseVector,omitempty"`
+}
+
+type QueryResponse struct {
+	Matches   []ScoredVector `json:"matches"`
+	Namespace string         `json:"namespace"`
+}
+
+type ScoredVector struct {
+	ID           string         `json:"id"`
+	Score        float32        `json:"score"`
+	Values       []float32      `json:"values,omitempty"`
+	SparseValues *SparseValues  `json:"sparseValues,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+}
+
+type DeleteRequest struct {
+	IDs       []string       `json:"ids,omitempty"`
+	DeleteAll bool           `json:"deleteAll,omitempty"`
+	Namespace string         `json:"namespace,omitempty"`
+	Filter    map[string]any `json:"filter,omitempty"`
+}
+
+type ListRequest struct {
+	Namespace       string
+	Prefix          string
+	Limit           int
+	PaginationToken string
+}
+
+type ListResponse struct {
+	Vectors    []ListItem  `json:"vectors"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Namespace  string      `json:"namespace"`
+}
+
+type ListItem struct {
+	ID string `json:"id"`
+}
+
+type Pagination struct {
+	Next string `json:"next"`
+}
+
+// indexDescriber resolves index hosts; PineconeClientInterface satisfies it.
+type indexDescriber interface {
+	DescribeIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error)
+}
+
+// DataPlaneClient talks to index hosts. Hosts are resolved with DescribeIndex and
+// cached per index; requests share the controller client's credentials and http.Client.
+type DataPlaneClient struct {
+	indexes indexDescriber
+	apiKey  string
+	tokens  *TokenSource
+	// httpClient returns the http.Client of each request, so that it follows the controller client's.
+	httpClient func() *http.Client
+
+	hosts map[string]string
+	mutex sync.Mutex
+}
+
+func NewDataPlaneClient(indexes indexDescriber, apiKey string, httpClient *http.Client) *DataPlaneClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &DataPlaneClient{
+		indexes: indexes,
+		apiKey:  apiKey,
+		httpClient: func() *http.Client {
+			return httpClient
+		},
+		hosts: make(map[string]string),
+	}
+}
+
+// Forget drops the cached host of an index, e.g. after it is deleted.
+func (c *DataPlaneClient) Forget(indexName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.hosts, indexName)
+}
+
+// hostURL returns the base URL of the index host.
+func (c *DataPlaneClient) hostURL(ctx context.Context, indexName string) (string, error) {
+	c.mutex.Lock()
+	host, ok := c.hosts[indexName]
+	c.mutex.Unlock()
+	if ok {
+		return host, nil
+	}
+
+	index, err := c.indexes.DescribeIndex(ctx, indexName)
+	if err != nil {
+		return "", err
+	}
+	if index == nil {
+		return "", fmt.Errorf("error: index %s not found", indexName)
+	}
+	if index.Status.Host == "" {
+		return "", fmt.Errorf("error: index %s has no host yet, it may not be ready", indexName)
+	}
+
+	host = index.Status.Host
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	c.mutex.Lock()
+	c.hosts[indexName] = host
+	c.mutex.Unlock()
+	return host, nil
+}
+
+// do sends a request to the index host and decodes the JSON response into resp, if not nil.
+func (c *DataPlaneClient) do(ctx context.Context, indexName string, method string, path string, query url.Values, body any, resp any) error {
+	host, err := c.hostURL(ctx, indexName)
+	if err != nil {
+		return err
+	}
+
+	u := host + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, u, payload)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Add("accept", "application/json")
+	if body != nil {
+		httpReq.Header.Add("content-type", "application/json")
+	}
+	if err := setAuthHeader(ctx, httpReq, c.apiKey, c.tokens); err != nil {
+		return err
+	}
+
+	res, err := c.httpClient().Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("error: index %s %s %s status code: %d: %s", indexName, method, path, res.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+
+	if resp == nil || len(resBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resBody, resp)
+}
+
+// DescribeIndexStats returns vector counts per namespace
+func (c *DataPlaneClient) DescribeIndexStats(ctx context.Context, indexName string, req DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error) {
+	var resp DescribeIndexStatsResponse
+	if err := c.do(ctx, indexName, "POST", "/describe_index_stats", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Upsert writes vectors, overwriting vectors with the same ID
+func (c *DataPlaneClient) Upsert(ctx context.Context, indexName string, req UpsertRequest) (*UpsertResponse, error) {
+	var resp UpsertResponse
+	if err := c.do(ctx, indexName, "POST", "/vectors/upsert", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Fetch reads vectors by ID
+func (c *DataPlaneClient) Fetch(ctx context.Context, indexName string, req FetchRequest) (*FetchResponse, error) {
+	query := url.Values{"ids": req.IDs}
+	if req.Namespace != "" {
+		query.Set("namespace", req.Namespace)
+	}
+
+	var resp FetchResponse
+	if err := c.do(ctx, indexName, "GET", "/vectors/fetch", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Query returns the vectors most similar to a vector or a stored vector ID
+func (c *DataPlaneClient) Query(ctx context.Context, indexName string, req QueryRequest) (*QueryResponse, error) {
+	var resp QueryResponse
+	if err := c.do(ctx, indexName, "POST", "/query", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes vectors by ID, by metadata filter or every vector of a namespace
+func (c *DataPlaneClient) Delete(ctx context.Context, indexName string, req DeleteRequest) error {
+	return c.do(ctx, indexName, "POST", "/vectors/delete", nil, req, nil)
+}
+
+// List lists vector IDs of a namespace one page at a time
+func (c *DataPlaneClient) List(ctx context.Context, indexName string, req ListRequest) (*ListResponse, error) {
+	query := url.Values{}
+	if req.Namespace != "" {
+		query.Set("namespace", req.Namespace)
+	}
+	if req.Prefix != "" {
+		query.Set("prefix", req.Prefix)
+	}
+	if req.Limit > 0 {
+		query.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.PaginationToken != "" {
+		query.Set("paginationToken", req.PaginationToken)
+	}
+
+	var resp ListResponse
+	if err := c.do(ctx, indexName, "GET", "/vectors/list", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
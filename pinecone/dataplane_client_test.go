@@ -0,0 +1,289 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+type countingDescriber struct {
+	host  string
+	calls int
+}
+
+func (d *countingDescriber) DescribeIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error) {
+	d.calls++
+	if d.host == "" {
+		return nil, nil
+	}
+	return &DescribeIndexResponse{
+		Database: DescribeDatabaseResponse{Name: indexName},
+		Status:   DescribeStatusResponse{Host: d.host, Ready: true},
+	}, nil
+}
+
+func TestDataPlaneClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "test_api_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+
+		switch r.URL.Path {
+		case "/describe_index_stats":
+			_, _ = w.Write([]byte(`{"namespaces":{"":{"vectorCount":2}},"dimension":2,"indexFullness":0.1,"totalVectorCount":2}`))
+		case "/vectors/upsert":
+			var req UpsertRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_ = json.NewEncoder(w).Encode(UpsertResponse{UpsertedCount: len(req.Vectors)})
+		case "/vectors/fetch":
+			_, _ = w.Write([]byte(`{"vectors":{"a":{"id":"a","values":[1,2]}},"namespace":"ns"}`))
+		case "/query":
+			_, _ = w.Write([]byte(`{"matches":[{"id":"a","score":0.5}],"namespace":""}`))
+		case "/vectors/delete":
+			_, _ = w.Write([]byte(`{}`))
+		case "/vectors/list":
+			_, _ = w.Write([]byte(`{"vectors":[{"id":"a"}],"pagination":{"next":"tok"},"namespace":"ns"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`not found`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	describer := &countingDescriber{host: server.URL}
+	cli := NewDataPlaneClient(describer, "test_api_key", server.Client())
+
+	stats, err := cli.DescribeIndexStats(ctx, "test", DescribeIndexStatsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TotalVectorCount != 2 || stats.Namespaces[""].VectorCount != 2 || stats.Dimension != 2 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	upserted, err := cli.Upsert(ctx, "test", UpsertRequest{Vectors: []Vector{{ID: "a", Values: []float32{1, 2}}}})
+	if err != nil || upserted.UpsertedCount != 1 {
+		t.Fatalf("unexpected upsert result: %+v, %v", upserted, err)
+	}
+
+	fetched, err := cli.Fetch(ctx, "test", FetchRequest{IDs: []string{"a", "b"}, Namespace: "ns"})
+	if err != nil || !reflect.DeepEqual(fetched.Vectors["a"].Values, []float32{1, 2}) {
+		t.Fatalf("unexpected fetch result: %+v, %v", fetched, err)
+	}
+
+	queried, err := cli.Query(ctx, "test", QueryRequest{TopK: 1, ID: "a"})
+	if err != nil || len(queried.Matches) != 1 || queried.Matches[0].Score != 0.5 {
+		t.Fatalf("unexpected query result: %+v, %v", queried, err)
+	}
+
+	if err := cli.Delete(ctx, "test", DeleteRequest{DeleteAll: true, Namespace: "ns"}); err != nil {
+		t.Fatal(err)
+	}
+
+	listed, err := cli.List(ctx, "test", ListRequest{Namespace: "ns", Limit: 1})
+	if err != nil || len(listed.Vectors) != 1 || listed.Pagination.Next != "tok" {
+		t.Fatalf("unexpected list result: %+v, %v", listed, err)
+	}
+
+	expected := []string{
+		"POST /describe_index_stats?",
+		"POST /vectors/upsert?",
+		"GET /vectors/fetch?ids=a&ids=b&namespace=ns",
+		"POST /query?",
+		"POST /vectors/delete?",
+		"GET /vectors/list?limit=1&namespace=ns",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, but received %v", expected, requests)
+	}
+
+	// The host is resolved once and cached until forgotten.
+	if describer.calls != 1 {
+		t.Fatalf("expected 1 DescribeIndex call, but received %d", describer.calls)
+	}
+	cli.Forget("test")
+	if _, err := cli.DescribeIndexStats(ctx, "test", DescribeIndexStatsRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if describer.calls != 2 {
+		t.Fatalf("expected 2 DescribeIndex calls after Forget, but received %d", describer.calls)
+	}
+}
+
+func TestDataPlaneClientErrors(t *testing.T) {
+	ctx := context.Background()
+
+	missing := NewDataPlaneClient(&countingDescriber{}, "test_api_key", nil)
+	if _, err := missing.Query(ctx, "missing", QueryRequest{TopK: 1}); err == nil {
+		t.Fatal("expected an error for a missing index")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"bad vector"}`))
+	}))
+	defer server.Close()
+
+	cli := NewDataPlaneClient(&countingDescriber{host: server.URL}, "test_api_key", server.Client())
+	_, err := cli.Upsert(ctx, "test", UpsertRequest{})
+	if err == nil || err.Error() != `error: index test POST /vectors/upsert status code: 400: {"message":"bad vector"}` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMockDataPlane(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2, Metric: MetricCosine}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cli.Upsert(ctx, "test", UpsertRequest{Namespace: "ns", Vectors: []Vector{
+		{ID: "a", Values: []float32{1, 0}, Metadata: map[string]any{"genre": "drama", "year": float64(2020)}},
+		{ID: "b", Values: []float32{0, 1}, Metadata: map[string]any{"genre": "comedy", "year": float64(2021)}},
+		{ID: "c", Values: []float32{1, 1}, Metadata: map[string]any{"genre": "drama", "year": float64(2022)}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Upsert(ctx, "test", UpsertRequest{Vectors: []Vector{{ID: "x", Values: []float32{1}}}}); err == nil {
+		t.Fatal("expected an error for a vector with the wrong dimension")
+	}
+
+	stats, _ := cli.DescribeIndexStats(ctx, "test", DescribeIndexStatsRequest{Filter: map[string]any{"genre": "drama"}})
+	if stats.TotalVectorCount != 2 {
+		t.Fatalf("expected 2 drama vectors, but received %+v", stats)
+	}
+
+	queried, _ := cli.Query(ctx, "test", QueryRequest{Namespace: "ns", TopK: 2, Vector: []float32{1, 0.1}})
+	if len(queried.Matches) != 2 || queried.Matches[0].ID != "a" || queried.Matches[1].ID != "c" {
+		t.Fatalf("unexpected matches: %+v", queried.Matches)
+	}
+
+	filtered, _ := cli.Query(ctx, "test", QueryRequest{Namespace: "ns", TopK: 10, ID: "a", Filter: map[string]any{
+		"$or": []any{
+			map[string]any{"year": map[string]any{"$gte": float64(2022)}},
+			map[string]any{"genre": map[string]any{"$in": []any{"comedy"}}},
+		},
+	}})
+	if len(filtered.Matches) != 2 || filtered.Matches[0].ID != "c" || filtered.Matches[1].ID != "b" {
+		t.Fatalf("unexpected filtered matches: %+v", filtered.Matches)
+	}
+
+	page, _ := cli.List(ctx, "test", ListRequest{Namespace: "ns", Limit: 2})
+	if len(page.Vectors) != 2 || page.Pagination == nil {
+		t.Fatalf("unexpected first page: %+v", page)
+	}
+	page, _ = cli.List(ctx, "test", ListRequest{Namespace: "ns", Limit: 2, PaginationToken: page.Pagination.Next})
+	if len(page.Vectors) != 1 || page.Vectors[0].ID != "c" || page.Pagination != nil {
+		t.Fatalf("unexpected last page: %+v", page)
+	}
+
+	_ = cli.Delete(ctx, "test", DeleteRequest{Namespace: "ns", IDs: []string{"a"}})
+	fetched, _ := cli.Fetch(ctx, "test", FetchRequest{Namespace: "ns", IDs: []string{"a", "b"}})
+	if len(fetched.Vectors) != 1 {
+		t.Fatalf("expected only b to remain, but received %+v", fetched.Vectors)
+	}
+
+	_ = cli.Delete(ctx, "test", DeleteRequest{Namespace: "ns", DeleteAll: true})
+	stats, _ = cli.DescribeIndexStats(ctx, "test", DescribeIndexStatsRequest{})
+	if stats.TotalVectorCount != 0 || len(stats.Namespaces) != 0 {
+		t.Fatalf("expected an empty index, but received %+v", stats)
+	}
+}
+
+func TestSparseValues(t *testing.T) {
+	if err := (&SparseValues{Indices: []uint32{1, 5}, Values: []float32{0.5, 0.2}}).Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&SparseValues{Indices: []uint32{1}, Values: []float32{0.5, 0.2}}).Validate(); err == nil {
+		t.Fatal("expected an error for mismatched indices and values")
+	}
+	if err := (&SparseValues{Indices: []uint32{1, 1}, Values: []float32{0.5, 0.2}}).Validate(); err == nil {
+		t.Fatal("expected an error for duplicate indices")
+	}
+
+	if err := checkSparseMetric("test", MetricDotProduct); err != nil {
+		t.Fatal(err)
+	}
+	err := checkSparseMetric("test", MetricCosine)
+	if err == nil || err.Error() != "error: index test has metric cosine, but sparse values require dotproduct" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMockHybridQuery(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "cosine", Dimension: 2, Metric: MetricCosine})
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "hybrid", Dimension: 2, Metric: MetricDotProduct})
+
+	sparse := &SparseValues{Indices: []uint32{3}, Values: []float32{1}}
+	if _, err := cli.Upsert(ctx, "cosine", UpsertRequest{Vectors: []Vector{{ID: "a", Values: []float32{1, 0}, SparseValues: sparse}}}); err == nil {
+		t.Fatal("expected an error for sparse values in a cosine index")
+	}
+
+	_, err = cli.Upsert(ctx, "hybrid", UpsertRequest{Vectors: []Vector{
+		{ID: "a", Values: []float32{1, 0}},
+		{ID: "b", Values: []float32{0.5, 0}, SparseValues: sparse},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dense, _ := cli.Query(ctx, "hybrid", QueryRequest{TopK: 2, Vector: []float32{1, 0}})
+	if dense.Matches[0].ID != "a" {
+		t.Fatalf("expected a to match best without sparse values, but received %+v", dense.Matches)
+	}
+	hybrid, _ := cli.Query(ctx, "hybrid", QueryRequest{TopK: 2, Vector: []float32{1, 0}, SparseVector: sparse, IncludeValues: true})
+	if hybrid.Matches[0].ID != "b" || hybrid.Matches[0].Score != 1.5 || !reflect.DeepEqual(hybrid.Matches[0].SparseValues, sparse) {
+		t.Fatalf("expected b to match best with sparse values, but received %+v", hybrid.Matches)
+	}
+	if _, err := cli.Query(ctx, "hybrid", QueryRequest{TopK: 1, Vector: []float32{1, 0}, SparseVector: &SparseValues{Indices: []uint32{1}}}); err == nil {
+		t.Fatal("expected an error for an invalid sparse vector")
+	}
+}
+
+func TestPineconeClientLiteralDataPlane(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "test_api_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/databases/test":
+			_, _ = w.Write([]byte(`{"database":{"name":"test"},"status":{"host":"test.svc.pinecone.io","ready":true}}`))
+		case "/query":
+			_, _ = w.Write([]byte(`{"matches":[{"id":"a","score":0.5}],"namespace":""}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	target, _ := url.Parse(server.URL)
+
+	// A client built as a literal, without NewClient, creates its data plane client on first use.
+	cli := &PineconeClient{
+		APIKey:      "test_api_key",
+		Environment: "test",
+		HTTPClient:  &http.Client{Transport: &rewriteTransport{target: target}},
+	}
+	queried, err := cli.Query(context.Background(), "test", QueryRequest{TopK: 1, ID: "a"})
+	if err != nil || len(queried.Matches) != 1 || queried.Matches[0].ID != "a" {
+		t.Fatalf("unexpected query result: %+v, %v", queried, err)
+	}
+}
@@ -0,0 +1,250 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	embedInputTypes = []string{"query", "passage"}
+	embedTruncates  = []string{"END", "NONE"}
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &embedDataSource{}
+	_ datasource.DataSourceWithConfigure      = &embedDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &embedDataSource{}
+)
+
+// NewEmbedDataSource is a helper function to simplify the provider implementation.
+func NewEmbedDataSource() datasource.DataSource {
+	return &embedDataSource{}
+}
+
+// embedDataSource is the data source implementation.
+type embedDataSource struct {
+	client PineconeClientInterface
+}
+
+type embedDataSourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Model       types.String   `tfsdk:"model"`
+	Inputs      []types.String `tfsdk:"inputs"`
+	InputType   types.String   `tfsdk:"input_type"`
+	Truncate    types.String   `tfsdk:"truncate"`
+	Dimension   types.Int64    `tfsdk:"dimension"`
+	Embeddings  types.List     `tfsdk:"embeddings"`
+	TotalTokens types.Int64    `tfsdk:"total_tokens"`
+}
+
+var embeddingAttributeTypes = map[string]attr.Type{
+	"text":   types.StringType,
+	"values": types.ListType{ElemType: types.Float64Type},
+}
+
+// Metadata returns the data source type name.
+func (d *embedDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embed"
+}
+
+// Schema defines the schema for the data source.
+func (d *embedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Embed texts with an embedding model hosted by Pinecone, e.g. to seed pinecone_vectors or to query an index. " +
+			"The texts are embedded again on every plan, which counts towards the inference usage of the project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the embeddings.",
+				Computed:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "The embedding model, see pinecone_embedding_models.",
+				Required:    true,
+			},
+			"inputs": schema.ListAttribute{
+				Description: "The texts to embed.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"input_type": schema.StringAttribute{
+				Description: "Whether the texts are queries or passages to store. Defaults to the model's default.",
+				Optional:    true,
+			},
+			"truncate": schema.StringAttribute{
+				Description: "END to truncate texts longer than the model's max sequence length, NONE to fail instead. Defaults to END.",
+				Optional:    true,
+			},
+			"dimension": schema.Int64Attribute{
+				Description: "The dimension of the embeddings. Defaults to the default dimension of the model.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"embeddings": schema.ListNestedAttribute{
+				Description: "An embedding per input, in the order of the inputs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Description: "The embedded text.",
+							Computed:    true,
+						},
+						"values": schema.ListAttribute{
+							Description: "The embedding of the text.",
+							Computed:    true,
+							ElementType: types.Float64Type,
+						},
+					},
+				},
+			},
+			"total_tokens": schema.Int64Attribute{
+				Description: "The number of tokens embedded.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that input_type and truncate are supported. The model and dimension
+// are checked against the models Pinecone lists when reading.
+func (d *embedDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config embedDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.InputType.IsNull() && !config.InputType.IsUnknown() && !slices.Contains(embedInputTypes, config.InputType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("input_type"), "Invalid input_type",
+			fmt.Sprintf("input_type must be query or passage, got %q.", config.InputType.ValueString()))
+	}
+	if !config.Truncate.IsNull() && !config.Truncate.IsUnknown() && !slices.Contains(embedTruncates, config.Truncate.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("truncate"), "Invalid truncate",
+			fmt.Sprintf("truncate must be END or NONE, got %q.", config.Truncate.ValueString()))
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *embedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data embedDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The model is checked against the same list as pinecone_embedding_models.
+	models, err := d.client.ListModels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error ListModels", err.Error())
+		return
+	}
+	model, err := lookupModel(models, data.Model.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("model"), "Invalid embedding model", err.Error())
+		return
+	}
+	if model.VectorType == "sparse" {
+		resp.Diagnostics.AddAttributeError(path.Root("model"), "Invalid embedding model",
+			fmt.Sprintf("error: embedding model %s is sparse, and pinecone_embed returns dense embeddings only", model.Model))
+		return
+	}
+	if !data.Dimension.IsNull() {
+		if err := model.CheckDimension(int(data.Dimension.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("dimension"), "Invalid dimension", err.Error())
+			return
+		}
+	}
+
+	embedReq := EmbedRequest{
+		Model:      data.Model.ValueString(),
+		Parameters: map[string]any{},
+		Inputs:     make([]EmbedInput, len(data.Inputs)),
+	}
+	for i, input := range data.Inputs {
+		embedReq.Inputs[i] = EmbedInput{Text: input.ValueString()}
+	}
+	if !data.InputType.IsNull() {
+		embedReq.Parameters["input_type"] = data.InputType.ValueString()
+	}
+	if !data.Truncate.IsNull() {
+		embedReq.Parameters["truncate"] = data.Truncate.ValueString()
+	}
+	if !data.Dimension.IsNull() {
+		embedReq.Parameters["dimension"] = data.Dimension.ValueInt64()
+	}
+
+	// Pinecone rejects an empty list of inputs, so there is nothing to embed.
+	embedded := &EmbedResponse{Model: model.Model, Data: []Embedding{}}
+	if len(embedReq.Inputs) > 0 {
+		embedded, err = d.client.Embed(ctx, embedReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Embed", err.Error())
+			return
+		}
+	}
+	if len(embedded.Data) != len(data.Inputs) {
+		resp.Diagnostics.AddError("Error Embed",
+			fmt.Sprintf("error: embedded %d inputs, got %d embeddings", len(data.Inputs), len(embedded.Data)))
+		return
+	}
+
+	elements := make([]attr.Value, len(embedded.Data))
+	for i, embedding := range embedded.Data {
+		values := make([]attr.Value, len(embedding.Values))
+		for j, v := range embedding.Values {
+			values[j] = types.Float64Value(float64(v))
+		}
+		object, diags := types.ObjectValue(embeddingAttributeTypes, map[string]attr.Value{
+			"text":   data.Inputs[i],
+			"values": types.ListValueMust(types.Float64Type, values),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elements[i] = object
+	}
+	embeddings, diags := types.ListValue(types.ObjectType{AttrTypes: embeddingAttributeTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without inputs, the dimension is the model's default.
+	if data.Dimension.IsNull() && len(embedded.Data) > 0 {
+		data.Dimension = types.Int64Value(int64(len(embedded.Data[0].Values)))
+	} else if data.Dimension.IsNull() {
+		data.Dimension = types.Int64Value(int64(model.DefaultDimension))
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d", data.Model.ValueString(), data.Dimension.ValueInt64()))
+	data.Embeddings = embeddings
+	data.TotalTokens = types.Int64Value(int64(embedded.Usage.TotalTokens))
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *embedDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	d.client = data.client
+}
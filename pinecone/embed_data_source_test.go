@@ -0,0 +1,152 @@
+package pinecone
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmbedDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_embedding_models" "llama" {
+    name = "llama-text-embed-v2"
+}
+
+data "pinecone_embed" "test" {
+    model      = data.pinecone_embedding_models.llama.models[0].name
+    inputs     = ["A heist in a dream.", "A robot cleans up Earth."]
+    input_type = "passage"
+    dimension  = 384
+}
+
+data "pinecone_embed" "default_dimension" {
+    model  = "multilingual-e5-large"
+    inputs = ["A heist in a dream."]
+}
+
+resource "pinecone_index" "test" {
+    name      = "test"
+    dimension = data.pinecone_embed.test.dimension
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "id", "llama-text-embed-v2/384"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "embeddings.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "embeddings.1.text", "A robot cleans up Earth."),
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "embeddings.1.values.#", "384"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "total_tokens", "10"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.default_dimension", "dimension", "1024"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.default_dimension", "embeddings.0.values.#", "1024"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "dimension", "384"),
+				),
+			},
+		},
+	})
+}
+
+// newModelClient lists a model the provider's catalog does not know.
+type newModelClient struct {
+	*MockPineconeClient
+}
+
+func (c *newModelClient) ListModels(ctx context.Context) ([]ModelInfo, error) {
+	models, err := c.MockPineconeClient.ListModels(ctx)
+	return append(models, ModelInfo{
+		Model:               "new-embed-v1",
+		Type:                "embed",
+		VectorType:          "dense",
+		SupportedDimensions: []int{256},
+		DefaultDimension:    256,
+		SupportedMetrics:    []string{"Cosine"},
+	}), err
+}
+
+func TestAccEmbedDataSourceListedModel(t *testing.T) {
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(&newModelClient{MockPineconeClient: mock}),
+		Steps: []resource.TestStep{
+			// Any model Pinecone lists is accepted, and without inputs nothing is embedded.
+			{
+				Config: providerConfig + `
+data "pinecone_embed" "test" {
+    model  = "new-embed-v1"
+    inputs = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "id", "new-embed-v1/256"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "dimension", "256"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "embeddings.#", "0"),
+					resource.TestCheckResourceAttr("data.pinecone_embed.test", "total_tokens", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEmbedDataSourceInvalid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name:   "Unknown model",
+			config: `model = "text-embedding-ada-002"`,
+			err:    `unknown embedding model "text-embedding-ada-002", must be one of\s+multilingual-e5-large`,
+		},
+		{
+			name:   "Sparse model",
+			config: `model = "pinecone-sparse-english-v0"`,
+			err:    `embedding model pinecone-sparse-english-v0 is sparse`,
+		},
+		{
+			name: "Unsupported dimension",
+			config: `model     = "multilingual-e5-large"
+    dimension = 384`,
+			err: `embedding model multilingual-e5-large has dimension 1024, got 384`,
+		},
+		{
+			name: "Unknown input type",
+			config: `model      = "multilingual-e5-large"
+    input_type = "document"`,
+			err: `input_type must be query or passage, got "document"`,
+		},
+		{
+			name: "Unknown truncate",
+			config: `model    = "multilingual-e5-large"
+    truncate = "START"`,
+			err: `truncate must be END or NONE, got "START"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+data "pinecone_embed" "test" {
+    inputs = ["A heist in a dream."]
+    ` + tc.config + `
+}
+`,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
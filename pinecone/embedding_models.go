@@ -0,0 +1,86 @@
+package pinecone
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EmbeddingModel is an embedding model Pinecone hosts for integrated inference.
+type EmbeddingModel struct {
+	Name        string
+	Description string
+	// Dimensions are the dimensions the model can produce. DefaultDimension is used unless the index sets one.
+	Dimensions       []int
+	DefaultDimension int
+	// Metrics are the metrics an index of the model may use. DefaultMetric is used unless the index sets one.
+	Metrics       []Metric
+	DefaultMetric Metric
+	// MaxSequenceLength is the number of tokens the model embeds per input; longer inputs are truncated.
+	MaxSequenceLength int
+}
+
+// EmbeddingModels is the catalog of dense embedding models an index can be tied to.
+var EmbeddingModels = []EmbeddingModel{
+	{
+		Name:              "multilingual-e5-large",
+		Description:       "Multilingual model for short passages and queries.",
+		Dimensions:        []int{1024},
+		DefaultDimension:  1024,
+		Metrics:           []Metric{MetricCosine, MetricEuclidean, MetricDotProduct},
+		DefaultMetric:     MetricCosine,
+		MaxSequenceLength: 507,
+	},
+	{
+		Name:              "llama-text-embed-v2",
+		Description:       "Multilingual model for long passages, with a choice of dimensions.",
+		Dimensions:        []int{384, 512, 768, 1024, 2048},
+		DefaultDimension:  1024,
+		Metrics:           []Metric{MetricCosine, MetricDotProduct},
+		DefaultMetric:     MetricCosine,
+		MaxSequenceLength: 2048,
+	},
+}
+
+// embeddingModelNames returns the names of the models in the catalog.
+func embeddingModelNames() []string {
+	names := make([]string, len(EmbeddingModels))
+	for i, model := range EmbeddingModels {
+		names[i] = model.Name
+	}
+	return names
+}
+
+// LookupEmbeddingModel returns the catalog entry of a model.
+func LookupEmbeddingModel(name string) (*EmbeddingModel, error) {
+	for i := range EmbeddingModels {
+		if EmbeddingModels[i].Name == name {
+			return &EmbeddingModels[i], nil
+		}
+	}
+	return nil, fmt.Errorf("error: unknown embedding model %q, must be one of %s", name, strings.Join(embeddingModelNames(), ", "))
+}
+
+// CheckDimension returns an error if the model cannot produce vectors of the dimension.
+func (m *EmbeddingModel) CheckDimension(dimension int) error {
+	if slices.Contains(m.Dimensions, dimension) {
+		return nil
+	}
+	dimensions := make([]string, len(m.Dimensions))
+	for i, d := range m.Dimensions {
+		dimensions[i] = fmt.Sprint(d)
+	}
+	return fmt.Errorf("error: embedding model %s has dimension %s, got %d", m.Name, strings.Join(dimensions, " or "), dimension)
+}
+
+// CheckMetric returns an error if an index of the model cannot use the metric.
+func (m *EmbeddingModel) CheckMetric(metric Metric) error {
+	if slices.Contains(m.Metrics, metric) {
+		return nil
+	}
+	metrics := make([]string, len(m.Metrics))
+	for i, supported := range m.Metrics {
+		metrics[i] = supported.String()
+	}
+	return fmt.Errorf("error: embedding model %s supports metric %s, got %s", m.Name, strings.Join(metrics, " or "), metric)
+}
@@ -0,0 +1,209 @@
+package pinecone
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &embeddingModelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &embeddingModelsDataSource{}
+)
+
+// NewEmbeddingModelsDataSource is a helper function to simplify the provider implementation.
+func NewEmbeddingModelsDataSource() datasource.DataSource {
+	return &embeddingModelsDataSource{}
+}
+
+// embeddingModelsDataSource is the data source implementation.
+type embeddingModelsDataSource struct {
+	client PineconeClientInterface
+}
+
+type embeddingModelsDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Models types.List   `tfsdk:"models"`
+}
+
+var embeddingModelAttributeTypes = map[string]attr.Type{
+	"name":                types.StringType,
+	"description":         types.StringType,
+	"vector_type":         types.StringType,
+	"dimensions":          types.ListType{ElemType: types.Int64Type},
+	"default_dimension":   types.Int64Type,
+	"metrics":             types.ListType{ElemType: types.StringType},
+	"default_metric":      types.StringType,
+	"max_sequence_length": types.Int64Type,
+}
+
+// Metadata returns the data source type name.
+func (d *embeddingModelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding_models"
+}
+
+// Schema defines the schema for the data source.
+func (d *embeddingModelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the embedding models Pinecone hosts for integrated inference and pinecone_embed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the model list.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only list this model. Reading fails if the model is unknown.",
+				Optional:    true,
+			},
+			"models": schema.ListNestedAttribute{
+				Description: "The embedding models.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the model.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "What the model is suited for.",
+							Computed:    true,
+						},
+						"vector_type": schema.StringAttribute{
+							Description: "dense or sparse. Sparse models have no dimension.",
+							Computed:    true,
+						},
+						"dimensions": schema.ListAttribute{
+							Description: "The dimensions the model can produce, empty for sparse models.",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"default_dimension": schema.Int64Attribute{
+							Description: "The dimension of an index of the model that sets none. Null for sparse models.",
+							Computed:    true,
+						},
+						"metrics": schema.ListAttribute{
+							Description: "The metrics an index of the model may use.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"default_metric": schema.StringAttribute{
+							Description: "The metric of an index of the model that sets none.",
+							Computed:    true,
+						},
+						"max_sequence_length": schema.Int64Attribute{
+							Description: "The number of tokens the model embeds per input. Longer inputs are truncated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *embeddingModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data embeddingModelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	models, err := d.client.ListModels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error ListModels", err.Error())
+		return
+	}
+	if !data.Name.IsNull() {
+		model, err := lookupModel(models, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error ListModels", err.Error())
+			return
+		}
+		models = []ModelInfo{*model}
+	}
+
+	elements := make([]attr.Value, 0, len(models))
+	for _, model := range models {
+		dimensions := make([]attr.Value, len(model.SupportedDimensions))
+		for i, dimension := range model.SupportedDimensions {
+			dimensions[i] = types.Int64Value(int64(dimension))
+		}
+		defaultDimension := types.Int64Null()
+		if model.DefaultDimension != 0 {
+			defaultDimension = types.Int64Value(int64(model.DefaultDimension))
+		}
+		metrics := make([]attr.Value, len(model.SupportedMetrics))
+		for i, metric := range model.SupportedMetrics {
+			metrics[i] = types.StringValue(strings.ToLower(metric))
+		}
+		object, diags := types.ObjectValue(embeddingModelAttributeTypes, map[string]attr.Value{
+			"name":                types.StringValue(model.Model),
+			"description":         types.StringValue(model.ShortDescription),
+			"vector_type":         types.StringValue(model.VectorType),
+			"dimensions":          types.ListValueMust(types.Int64Type, dimensions),
+			"default_dimension":   defaultDimension,
+			"metrics":             types.ListValueMust(types.StringType, metrics),
+			"default_metric":      types.StringValue(defaultModelMetric(model)),
+			"max_sequence_length": types.Int64Value(int64(model.MaxSequenceLength)),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elements = append(elements, object)
+	}
+	modelsValue, diags := types.ListValue(types.ObjectType{AttrTypes: embeddingModelAttributeTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("embedding_models")
+	if !data.Name.IsNull() {
+		data.ID = types.StringValue(data.Name.ValueString())
+	}
+	data.Models = modelsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// defaultModelMetric returns the metric Pinecone gives an index of the model that sets
+// none: cosine when the model supports it, else its only metric, e.g. dotproduct for
+// sparse models.
+func defaultModelMetric(model ModelInfo) string {
+	for _, metric := range model.SupportedMetrics {
+		if strings.EqualFold(metric, MetricCosine.String()) {
+			return MetricCosine.String()
+		}
+	}
+	if len(model.SupportedMetrics) == 0 {
+		return ""
+	}
+	return strings.ToLower(model.SupportedMetrics[0])
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *embeddingModelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	d.client = data.client
+}
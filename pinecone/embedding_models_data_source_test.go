@@ -0,0 +1,61 @@
+package pinecone
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmbeddingModelsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_embedding_models" "all" {}
+
+data "pinecone_embedding_models" "llama" {
+    name = "llama-text-embed-v2"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "id", "embedding_models"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.#", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.0.name", "multilingual-e5-large"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.0.metrics.#", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.0.vector_type", "dense"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.2.name", "pinecone-sparse-english-v0"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.2.vector_type", "sparse"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.2.dimensions.#", "0"),
+					resource.TestCheckNoResourceAttr("data.pinecone_embedding_models.all", "models.2.default_dimension"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.all", "models.2.default_metric", "dotproduct"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "id", "llama-text-embed-v2"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "models.#", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "models.0.dimensions.#", "5"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "models.0.dimensions.4", "2048"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "models.0.default_dimension", "1024"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "models.0.default_metric", "cosine"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "models.0.metrics.1", "dotproduct"),
+					resource.TestCheckResourceAttr("data.pinecone_embedding_models.llama", "models.0.max_sequence_length", "2048"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEmbeddingModelsDataSourceUnknownModel(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_embedding_models" "ada" {
+    name = "text-embedding-ada-002"
+}
+`,
+				ExpectError: regexp.MustCompile(`unknown embedding model "text-embedding-ada-002"`),
+			},
+		},
+	})
+}
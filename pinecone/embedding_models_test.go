@@ -0,0 +1,50 @@
+package pinecone
+
+import (
+	"testing"
+)
+
+func TestLookupEmbeddingModel(t *testing.T) {
+	model, err := LookupEmbeddingModel("llama-text-embed-v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.DefaultDimension != 1024 || model.DefaultMetric != MetricCosine {
+		t.Fatalf("unexpected defaults %d and %s", model.DefaultDimension, model.DefaultMetric)
+	}
+
+	if _, err := LookupEmbeddingModel("text-embedding-ada-002"); err == nil {
+		t.Fatal("expected an error for an unknown model")
+	}
+}
+
+func TestEmbeddingModelCheck(t *testing.T) {
+	model, err := LookupEmbeddingModel("llama-text-embed-v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name      string
+		dimension int
+		metric    Metric
+		ok        bool
+	}{
+		{name: "Default dimension and metric", dimension: 1024, metric: MetricCosine, ok: true},
+		{name: "Smaller dimension", dimension: 384, metric: MetricDotProduct, ok: true},
+		{name: "Unsupported dimension", dimension: 1536, metric: MetricCosine, ok: false},
+		{name: "Unsupported metric", dimension: 1024, metric: MetricEuclidean, ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := model.CheckDimension(tc.dimension)
+			if err == nil {
+				err = model.CheckMetric(tc.metric)
+			}
+			if (err == nil) != tc.ok {
+				t.Fatalf("test '%s' failed: expected ok %v, but received error %v", tc.name, tc.ok, err)
+			}
+		})
+	}
+}
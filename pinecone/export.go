@@ -0,0 +1,242 @@
+package pinecone
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// Pinecone lists at most 100 IDs per page.
+	listPageSize = 100
+
+	// ExportManifestFile is the name of the manifest in an export directory.
+	ExportManifestFile = "manifest.json"
+
+	exportDefaultNamespaceFile = "__default__.jsonl"
+)
+
+// streamVectors lists the IDs of a namespace page by page, starting at token,
+// fetches them in parallel batches and calls f with the vectors in list order
+// and the pagination token to resume after them, "" once the namespace is done.
+// Every call to f covers up to batchSize * parallelism IDs.
+func streamVectors(ctx context.Context, client DataPlaneClientInterface, indexName string, namespace string, token string, batchSize int, parallelism int, f func(vectors []Vector, next string) error) error {
+	window := batchSize * parallelism
+	for {
+		var ids []string
+		more := true
+		for more && len(ids) < window {
+			page, err := client.List(ctx, indexName, ListRequest{
+				Namespace:       namespace,
+				Limit:           min(listPageSize, window-len(ids)),
+				PaginationToken: token,
+			})
+			if err != nil {
+				return err
+			}
+			for _, item := range page.Vectors {
+				ids = append(ids, item.ID)
+			}
+			token = ""
+			if page.Pagination != nil {
+				token = page.Pagination.Next
+			}
+			more = token != ""
+		}
+
+		batches := make([]map[string]Vector, (len(ids)+batchSize-1)/batchSize)
+		err := forEachBatch(ctx, len(ids), batchSize, parallelism, func(ctx context.Context, start int, end int) error {
+			fetched, err := client.Fetch(ctx, indexName, FetchRequest{
+				IDs:       ids[start:end],
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+			batches[start/batchSize] = fetched.Vectors
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Vectors deleted since they were listed are skipped.
+		vectors := make([]Vector, 0, len(ids))
+		for i, id := range ids {
+			if v, ok := batches[i/batchSize][id]; ok {
+				v.ID = id
+				vectors = append(vectors, v)
+			}
+		}
+		if err := f(vectors, token); err != nil {
+			return err
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+// ExportRequest describes what to export and where.
+type ExportRequest struct {
+	IndexName string
+	// Namespaces to export; all namespaces of the index if empty.
+	Namespaces  []string
+	Directory   string
+	BatchSize   int
+	Parallelism int
+}
+
+// ExportManifest describes an export directory.
+type ExportManifest struct {
+	IndexName        string                    `json:"index_name"`
+	Dimension        int                       `json:"dimension"`
+	Metric           string                    `json:"metric"`
+	Format           string                    `json:"format"`
+	ExportedAt       string                    `json:"exported_at"`
+	TotalVectorCount int                       `json:"total_vector_count"`
+	Namespaces       []ExportManifestNamespace `json:"namespaces"`
+}
+
+// ExportManifestNamespace describes the file of one namespace.
+type ExportManifestNamespace struct {
+	Namespace   string `json:"namespace"`
+	File        string `json:"file"`
+	VectorCount int    `json:"vector_count"`
+	SHA256      string `json:"sha256"`
+}
+
+// exportFileName returns the file name of a namespace in an export directory.
+func exportFileName(namespace string) string {
+	if namespace == "" {
+		return exportDefaultNamespaceFile
+	}
+	return url.PathEscape(namespace) + ".jsonl"
+}
+
+// ExportIndex writes the vectors of each namespace to a JSONL file of the
+// directory, in the format pinecone_vectors reads, and a manifest of the files.
+func ExportIndex(ctx context.Context, client PineconeClientInterface, req ExportRequest) (*ExportManifest, error) {
+	index, err := client.DescribeIndex(ctx, req.IndexName)
+	if err != nil {
+		return nil, err
+	}
+	if index == nil {
+		return nil, fmt.Errorf("error: index %s not found", req.IndexName)
+	}
+
+	namespaces := req.Namespaces
+	if len(namespaces) == 0 {
+		stats, err := client.DescribeIndexStats(ctx, req.IndexName, DescribeIndexStatsRequest{})
+		if err != nil {
+			return nil, err
+		}
+		namespaces = sortedKeys(stats.Namespaces)
+	}
+
+	if err := os.MkdirAll(req.Directory, 0o755); err != nil {
+		return nil, err
+	}
+
+	manifest := &ExportManifest{
+		IndexName:  req.IndexName,
+		Dimension:  index.Database.Dimension,
+		Metric:     index.Database.Metric.String(),
+		Format:     VectorsFormatJSONL,
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+		Namespaces: []ExportManifestNamespace{},
+	}
+	files := make(map[string]string, len(namespaces))
+	for _, namespace := range namespaces {
+		file := exportFileName(namespace)
+		if other, ok := files[file]; ok {
+			return nil, fmt.Errorf("error: namespaces %q and %q would both be exported to %s", other, namespace, file)
+		}
+		files[file] = namespace
+
+		count, checksum, err := exportNamespace(ctx, client, req, namespace, filepath.Join(req.Directory, file))
+		if err != nil {
+			return nil, err
+		}
+		manifest.TotalVectorCount += count
+		manifest.Namespaces = append(manifest.Namespaces, ExportManifestNamespace{
+			Namespace:   namespace,
+			File:        file,
+			VectorCount: count,
+			SHA256:      checksum,
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(req.Directory, ExportManifestFile), append(data, '\n'), 0o644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// exportNamespace writes the vectors of a namespace to path and returns their
+// count and the SHA-256 of the file.
+func exportNamespace(ctx context.Context, client DataPlaneClientInterface, req ExportRequest, namespace string, path string) (int, string, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	writer := bufio.NewWriter(io.MultiWriter(file, hash))
+	encoder := json.NewEncoder(writer)
+
+	count := 0
+	err = streamVectors(ctx, client, req.IndexName, namespace, "", req.BatchSize, req.Parallelism, func(vectors []Vector, _ string) error {
+		for _, v := range vectors {
+			record := vectorRecord{
+				ID:           v.ID,
+				Values:       v.Values,
+				SparseValues: v.SparseValues,
+				Metadata:     v.Metadata,
+			}
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+		}
+		count += len(vectors)
+		return nil
+	})
+	if err != nil {
+		return 0, "", fmt.Errorf("error: export namespace %q of index %s: %w", namespace, req.IndexName, err)
+	}
+	if err := writer.Flush(); err != nil {
+		return 0, "", err
+	}
+	if err := file.Close(); err != nil {
+		return 0, "", err
+	}
+	return count, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// fileSHA256 returns the SHA-256 of a file.
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,126 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// newSeededMockClient returns a mock client with an index "test" of dimension 2 holding count vectors per namespace.
+func newSeededMockClient(t *testing.T, count int, namespaces ...string) *MockPineconeClient {
+	t.Helper()
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2}); err != nil {
+		t.Fatal(err)
+	}
+	for _, namespace := range namespaces {
+		vectors := make([]Vector, count)
+		for i := range vectors {
+			vectors[i] = Vector{
+				ID:       fmt.Sprintf("%s-%03d", namespace, i),
+				Values:   []float32{float32(i), 1},
+				Metadata: map[string]any{"i": float64(i)},
+			}
+		}
+		if _, err := cli.Upsert(ctx, "test", UpsertRequest{Namespace: namespace, Vectors: vectors}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cli
+}
+
+func TestStreamVectors(t *testing.T) {
+	ctx := context.Background()
+	cli := newSeededMockClient(t, 250, "ns")
+
+	var ids []string
+	var tokens []string
+	err := streamVectors(ctx, cli, "test", "ns", "", 50, 2, func(vectors []Vector, next string) error {
+		for _, v := range vectors {
+			ids = append(ids, v.ID)
+		}
+		tokens = append(tokens, next)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 250 || ids[0] != "ns-000" || ids[249] != "ns-249" {
+		t.Fatalf("expected ns-000 to ns-249 in order, but received %d IDs from %s", len(ids), ids[0])
+	}
+	// 100 IDs per call, as batch_size 50 * parallelism 2.
+	if len(tokens) != 3 || tokens[2] != "" {
+		t.Fatalf("expected 3 calls ending with an empty token, but received %q", tokens)
+	}
+
+	// Resume from the checkpoint after the first call.
+	ids = nil
+	err = streamVectors(ctx, cli, "test", "ns", tokens[0], 50, 2, func(vectors []Vector, next string) error {
+		for _, v := range vectors {
+			ids = append(ids, v.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 150 || ids[0] != "ns-100" {
+		t.Fatalf("expected to resume at ns-100, but received %d IDs", len(ids))
+	}
+}
+
+func TestExportIndex(t *testing.T) {
+	ctx := context.Background()
+	cli := newSeededMockClient(t, 3, "", "tenant/a")
+	dir := filepath.Join(t.TempDir(), "export")
+
+	manifest, err := ExportIndex(ctx, cli, ExportRequest{IndexName: "test", Directory: dir, BatchSize: 2, Parallelism: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.TotalVectorCount != 6 || manifest.Dimension != 2 || len(manifest.Namespaces) != 2 {
+		t.Fatalf("unexpected manifest: %+v", manifest)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ExportManifestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written ExportManifest
+	if err := json.Unmarshal(data, &written); err != nil || !reflect.DeepEqual(&written, manifest) {
+		t.Fatalf("expected the manifest file to match %+v, but received %+v, %v", manifest, written, err)
+	}
+
+	for i, expected := range []struct{ namespace, file string }{{"", "__default__.jsonl"}, {"tenant/a", "tenant%2Fa.jsonl"}} {
+		ns := manifest.Namespaces[i]
+		if ns.Namespace != expected.namespace || ns.File != expected.file || ns.VectorCount != 3 {
+			t.Fatalf("unexpected namespace %d: %+v", i, ns)
+		}
+		checksum, err := fileSHA256(filepath.Join(dir, ns.File))
+		if err != nil || checksum != ns.SHA256 {
+			t.Fatalf("expected checksum %s, but received %s, %v", ns.SHA256, checksum, err)
+		}
+
+		// The files round-trip through pinecone_vectors.
+		file, err := LoadVectorsFile(filepath.Join(dir, ns.File), VectorsFormatJSONL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fetched, _ := cli.Fetch(ctx, "test", FetchRequest{Namespace: ns.Namespace, IDs: []string{file.Vectors[1].ID}})
+		if !reflect.DeepEqual(fetched.Vectors[file.Vectors[1].ID], file.Vectors[1]) {
+			t.Fatalf("expected %+v to match the index, but received %+v", file.Vectors[1], fetched.Vectors)
+		}
+	}
+
+	if _, err := ExportIndex(ctx, cli, ExportRequest{IndexName: "missing", Directory: dir, BatchSize: 1, Parallelism: 1}); err == nil {
+		t.Fatal("expected an error for a missing index")
+	}
+}
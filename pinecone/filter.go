@@ -0,0 +1,280 @@
+package pinecone
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FilterOperator is a comparison operator of a metadata filter.
+type FilterOperator string
+
+const (
+	FilterOperatorEq     FilterOperator = "$eq"
+	FilterOperatorNe     FilterOperator = "$ne"
+	FilterOperatorGt     FilterOperator = "$gt"
+	FilterOperatorGte    FilterOperator = "$gte"
+	FilterOperatorLt     FilterOperator = "$lt"
+	FilterOperatorLte    FilterOperator = "$lte"
+	FilterOperatorIn     FilterOperator = "$in"
+	FilterOperatorNin    FilterOperator = "$nin"
+	FilterOperatorExists FilterOperator = "$exists"
+)
+
+const (
+	filterAnd = "$and"
+	filterOr  = "$or"
+)
+
+// filterOperandKinds lists what each operator compares a field with.
+var filterOperandKinds = map[FilterOperator]string{
+	FilterOperatorEq:     "a string, number or boolean",
+	FilterOperatorNe:     "a string, number or boolean",
+	FilterOperatorGt:     "a number",
+	FilterOperatorGte:    "a number",
+	FilterOperatorLt:     "a number",
+	FilterOperatorLte:    "a number",
+	FilterOperatorIn:     "a non-empty list of strings or numbers",
+	FilterOperatorNin:    "a non-empty list of strings or numbers",
+	FilterOperatorExists: "a boolean",
+}
+
+// MetadataFilter is a validated metadata filter. A vector matches when it
+// matches every condition, every filter of And and at least one filter of Or.
+type MetadataFilter struct {
+	Conditions []FilterCondition
+	And        []*MetadataFilter
+	Or         []*MetadataFilter
+}
+
+// FilterCondition compares a metadata field with a value.
+type FilterCondition struct {
+	Field    string
+	Operator FilterOperator
+	// Value is a string, float64 or bool, or a []any of strings and float64s for $in and $nin.
+	Value any
+}
+
+// FilterError reports an invalid part of a filter document. Path locates it,
+// e.g. filter.$and[1].genre.$regex.
+type FilterError struct {
+	Path    string
+	Message string
+}
+
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("error: invalid filter at %s: %s", e.Path, e.Message)
+}
+
+// ParseMetadataFilterJSON parses and validates a JSON filter document.
+func ParseMetadataFilterJSON(s string) (*MetadataFilter, error) {
+	var doc any
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, &FilterError{Path: "filter", Message: "must be a JSON object: " + err.Error()}
+	}
+	return ParseMetadataFilter(doc)
+}
+
+// ParseMetadataFilter validates a decoded filter document, in which objects
+// are map[string]any, lists are []any and numbers are float64.
+func ParseMetadataFilter(doc any) (*MetadataFilter, error) {
+	return parseMetadataFilter(doc, "filter")
+}
+
+func parseMetadataFilter(doc any, path string) (*MetadataFilter, error) {
+	object, ok := doc.(map[string]any)
+	if !ok {
+		return nil, &FilterError{Path: path, Message: fmt.Sprintf("must be an object, got %s", filterKind(doc))}
+	}
+	if len(object) == 0 {
+		return nil, &FilterError{Path: path, Message: "must not be empty"}
+	}
+
+	filter := &MetadataFilter{}
+	for _, key := range sortedKeys(object) {
+		keyPath := path + "." + key
+		value := object[key]
+
+		switch {
+		case key == filterAnd || key == filterOr:
+			clauses, ok := value.([]any)
+			if !ok || len(clauses) == 0 {
+				return nil, &FilterError{Path: keyPath, Message: "must be a non-empty list of filters"}
+			}
+			for i, clause := range clauses {
+				parsed, err := parseMetadataFilter(clause, fmt.Sprintf("%s[%d]", keyPath, i))
+				if err != nil {
+					return nil, err
+				}
+				if key == filterAnd {
+					filter.And = append(filter.And, parsed)
+				} else {
+					filter.Or = append(filter.Or, parsed)
+				}
+			}
+		case strings.HasPrefix(key, "$"):
+			if _, ok := filterOperandKinds[FilterOperator(key)]; ok {
+				return nil, &FilterError{Path: keyPath, Message: fmt.Sprintf("%s must be applied to a field, e.g. {\"genre\": {\"%s\": ...}}", key, key)}
+			}
+			return nil, &FilterError{Path: keyPath, Message: fmt.Sprintf("unknown operator %s, only $and and $or combine filters", key)}
+		case key == "":
+			return nil, &FilterError{Path: keyPath, Message: "field name must not be empty"}
+		default:
+			conditions, err := parseFilterConditions(key, value, keyPath)
+			if err != nil {
+				return nil, err
+			}
+			filter.Conditions = append(filter.Conditions, conditions...)
+		}
+	}
+	return filter, nil
+}
+
+// parseFilterConditions parses the conditions on a field, either an object of
+// operators or a value that is shorthand for $eq.
+func parseFilterConditions(field string, value any, path string) ([]FilterCondition, error) {
+	operators, ok := value.(map[string]any)
+	if !ok {
+		if _, isList := value.([]any); isList {
+			return nil, &FilterError{Path: path, Message: "a list cannot be matched directly, use $in to match any of its values"}
+		}
+		if err := checkFilterOperand(FilterOperatorEq, value, path); err != nil {
+			return nil, err
+		}
+		return []FilterCondition{{Field: field, Operator: FilterOperatorEq, Value: value}}, nil
+	}
+	if len(operators) == 0 {
+		return nil, &FilterError{Path: path, Message: "must hold at least one operator"}
+	}
+
+	var conditions []FilterCondition
+	for _, key := range sortedKeys(operators) {
+		keyPath := path + "." + key
+		operator := FilterOperator(key)
+		if _, ok := filterOperandKinds[operator]; !ok {
+			if key == filterAnd || key == filterOr {
+				return nil, &FilterError{Path: keyPath, Message: fmt.Sprintf("%s combines filters and cannot be applied to a field", key)}
+			}
+			if !strings.HasPrefix(key, "$") {
+				return nil, &FilterError{Path: keyPath, Message: "nested fields are not supported, metadata is flat"}
+			}
+			return nil, &FilterError{Path: keyPath, Message: fmt.Sprintf("unknown operator %s, must be one of %s", key, strings.Join(sortedKeys(filterOperatorNames()), ", "))}
+		}
+		if err := checkFilterOperand(operator, operators[key], keyPath); err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, FilterCondition{Field: field, Operator: operator, Value: operators[key]})
+	}
+	return conditions, nil
+}
+
+func filterOperatorNames() map[string]bool {
+	names := make(map[string]bool, len(filterOperandKinds))
+	for operator := range filterOperandKinds {
+		names[string(operator)] = true
+	}
+	return names
+}
+
+// checkFilterOperand checks the type of the value an operator compares with.
+func checkFilterOperand(operator FilterOperator, value any, path string) error {
+	var ok bool
+	switch operator {
+	case FilterOperatorEq, FilterOperatorNe:
+		switch value.(type) {
+		case string, float64, bool:
+			ok = true
+		}
+	case FilterOperatorGt, FilterOperatorGte, FilterOperatorLt, FilterOperatorLte:
+		_, ok = value.(float64)
+	case FilterOperatorIn, FilterOperatorNin:
+		var values []any
+		values, ok = value.([]any)
+		ok = ok && len(values) > 0
+		for i, v := range values {
+			switch v.(type) {
+			case string, float64:
+			default:
+				return &FilterError{Path: fmt.Sprintf("%s[%d]", path, i), Message: fmt.Sprintf("must be a string or number, got %s", filterKind(v))}
+			}
+		}
+	case FilterOperatorExists:
+		_, ok = value.(bool)
+	}
+	if !ok {
+		return &FilterError{Path: path, Message: fmt.Sprintf("%s takes %s, got %s", operator, filterOperandKinds[operator], filterKind(value))}
+	}
+	return nil
+}
+
+// filterKind names the JSON type of a decoded value for error messages.
+func filterKind(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case []any:
+		if len(v) == 0 {
+			return "an empty list"
+		}
+		return "a list"
+	case map[string]any:
+		return "an object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// Map returns the filter document sent to Pinecone, with every operator explicit.
+func (f *MetadataFilter) Map() map[string]any {
+	doc := make(map[string]any)
+	for _, c := range f.Conditions {
+		operators, ok := doc[c.Field].(map[string]any)
+		if !ok {
+			operators = make(map[string]any)
+			doc[c.Field] = operators
+		}
+		operators[string(c.Operator)] = c.Value
+	}
+	if len(f.And) > 0 {
+		doc[filterAnd] = filterMaps(f.And)
+	}
+	if len(f.Or) > 0 {
+		doc[filterOr] = filterMaps(f.Or)
+	}
+	return doc
+}
+
+func filterMaps(filters []*MetadataFilter) []any {
+	maps := make([]any, len(filters))
+	for i, f := range filters {
+		maps[i] = f.Map()
+	}
+	return maps
+}
+
+// JSON returns the filter document sent to Pinecone as JSON.
+func (f *MetadataFilter) JSON() string {
+	data, _ := json.Marshal(f.Map())
+	return string(data)
+}
+
+// decodeFilter parses and validates a JSON metadata filter attribute; a null filter matches every vector.
+func decodeFilter(filter types.String) (map[string]any, error) {
+	if filter.IsNull() || filter.IsUnknown() {
+		return nil, nil
+	}
+
+	parsed, err := ParseMetadataFilterJSON(filter.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Map(), nil
+}
@@ -0,0 +1,77 @@
+package pinecone
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMetadataFilterJSON(t *testing.T) {
+	filter, err := ParseMetadataFilterJSON(`{
+		"genre": "drama",
+		"year": {"$gte": 2020, "$lt": 2024},
+		"$or": [{"tags": {"$in": ["a", 1]}}, {"draft": {"$exists": false}}]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &MetadataFilter{
+		Conditions: []FilterCondition{
+			{Field: "genre", Operator: FilterOperatorEq, Value: "drama"},
+			{Field: "year", Operator: FilterOperatorGte, Value: float64(2020)},
+			{Field: "year", Operator: FilterOperatorLt, Value: float64(2024)},
+		},
+		Or: []*MetadataFilter{
+			{Conditions: []FilterCondition{{Field: "tags", Operator: FilterOperatorIn, Value: []any{"a", float64(1)}}}},
+			{Conditions: []FilterCondition{{Field: "draft", Operator: FilterOperatorExists, Value: false}}},
+		},
+	}
+	if !reflect.DeepEqual(filter, expected) {
+		t.Fatalf("expected %+v, but received %+v", expected, filter)
+	}
+
+	expectedJSON := `{"$or":[{"tags":{"$in":["a",1]}},{"draft":{"$exists":false}}],"genre":{"$eq":"drama"},"year":{"$gte":2020,"$lt":2024}}`
+	if filter.JSON() != expectedJSON {
+		t.Fatalf("expected %s, but received %s", expectedJSON, filter.JSON())
+	}
+}
+
+func TestParseMetadataFilterErrors(t *testing.T) {
+	tests := []struct {
+		filter  string
+		path    string
+		message string
+	}{
+		{`[]`, "filter", "must be an object, got an empty list"},
+		{`{`, "filter", "must be a JSON object"},
+		{`{}`, "filter", "must not be empty"},
+		{`{"$and": []}`, "filter.$and", "non-empty list of filters"},
+		{`{"$and": [{"genre": "drama"}, {}]}`, "filter.$and[1]", "must not be empty"},
+		{`{"$and": [{"genre": "drama"}, {"genre": {"$regex": "d.*"}}]}`, "filter.$and[1].genre.$regex", "unknown operator $regex"},
+		{`{"$eq": {"genre": "drama"}}`, "filter.$eq", "must be applied to a field"},
+		{`{"$not": {"genre": "drama"}}`, "filter.$not", "unknown operator $not"},
+		{`{"genre": {"$or": [{"$eq": "drama"}]}}`, "filter.genre.$or", "cannot be applied to a field"},
+		{`{"author": {"name": "x"}}`, "filter.author.name", "nested fields are not supported"},
+		{`{"genre": {}}`, "filter.genre", "at least one operator"},
+		{`{"genre": ["drama"]}`, "filter.genre", "use $in"},
+		{`{"genre": null}`, "filter.genre", "$eq takes a string, number or boolean, got null"},
+		{`{"year": {"$gt": "2020"}}`, "filter.year.$gt", "$gt takes a number, got a string"},
+		{`{"genre": {"$in": []}}`, "filter.genre.$in", "non-empty list of strings or numbers, got an empty list"},
+		{`{"genre": {"$nin": ["a", true]}}`, "filter.genre.$nin[1]", "must be a string or number, got a boolean"},
+		{`{"draft": {"$exists": 1}}`, "filter.draft.$exists", "$exists takes a boolean, got a number"},
+		{`{"": 1}`, "filter.", "field name must not be empty"},
+	}
+
+	for _, test := range tests {
+		_, err := ParseMetadataFilterJSON(test.filter)
+		var filterErr *FilterError
+		if !errors.As(err, &filterErr) {
+			t.Fatalf("expected a FilterError for %s, but received %v", test.filter, err)
+		}
+		if filterErr.Path != test.path || !strings.Contains(filterErr.Message, test.message) {
+			t.Fatalf("expected an error at %s containing %q for %s, but received %v", test.path, test.message, test.filter, err)
+		}
+	}
+}
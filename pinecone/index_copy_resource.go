@@ -0,0 +1,473 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &indexCopyResource{}
+	_ resource.ResourceWithConfigure      = &indexCopyResource{}
+	_ resource.ResourceWithModifyPlan     = &indexCopyResource{}
+	_ resource.ResourceWithValidateConfig = &indexCopyResource{}
+)
+
+// NewIndexCopyResource is a helper function to simplify the provider implementation.
+func NewIndexCopyResource() resource.Resource {
+	return &indexCopyResource{}
+}
+
+// indexCopyResource is the resource implementation.
+type indexCopyResource struct {
+	client    PineconeClientInterface
+	newClient func(apiKey string, environment string) (PineconeClientInterface, error)
+}
+
+type indexCopyResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	SourceIndexName      types.String `tfsdk:"source_index_name"`
+	DestinationIndexName types.String `tfsdk:"destination_index_name"`
+	Source               types.Object `tfsdk:"source"`
+	Namespaces           types.List   `tfsdk:"namespaces"`
+	BatchSize            types.Int64  `tfsdk:"batch_size"`
+	Parallelism          types.Int64  `tfsdk:"parallelism"`
+	Triggers             types.Map    `tfsdk:"triggers"`
+	AllowPartial         types.Bool   `tfsdk:"allow_partial"`
+	Progress             types.Map    `tfsdk:"progress"`
+	Completed            types.Bool   `tfsdk:"completed"`
+}
+
+type indexCopySourceModel struct {
+	APIKey      types.String `tfsdk:"api_key"`
+	Environment types.String `tfsdk:"environment"`
+}
+
+var copyProgressAttributeTypes = map[string]attr.Type{
+	"copied":     types.Int64Type,
+	"next_token": types.StringType,
+	"done":       types.BoolType,
+}
+
+// Metadata returns the resource type name.
+func (r *indexCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_copy"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexCopyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Copy the vectors of an index into another index, possibly of another project or environment. " +
+			"Progress is checkpointed in state: if the copy fails after copying vectors, the apply fails and Terraform taints " +
+			"the copy, so the next apply copies again from the start. With allow_partial, the apply warns instead and leaves " +
+			"completed false, and the next apply resumes where it stopped. Once copied, the vector count " +
+			"of each namespace is compared between the indexes. Listing vector IDs requires a serverless source index. " +
+			"Destroying the resource leaves the copied vectors in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the copy, source_index_name/destination_index_name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_index_name": schema.StringAttribute{
+				Description: "The name of the index to copy from.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_index_name": schema.StringAttribute{
+				Description: "The name of the index to copy to, with the provider's credentials. It must have the dimension of the source index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.SingleNestedAttribute{
+				Description: "The credentials to read the source index with. Defaults to the provider's.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Description: "The API key of the source project. Defaults to the provider's API key or OAuth client credentials.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"environment": schema.StringAttribute{
+						Description: "The environment of the source index. Defaults to the provider's.",
+						Optional:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"namespaces": schema.ListAttribute{
+				Description: "The namespaces to copy. Defaults to every namespace of the source index.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "The number of vectors per fetch and upsert request.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultUpsertBatchSize),
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: "The number of requests to send at once, which bounds the vectors in flight to batch_size * parallelism.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultParallelism),
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that copy the index again when they change.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_partial": schema.BoolAttribute{
+				Description: "Succeed with a warning and completed = false when the copy fails after copying vectors, so that the next " +
+					"apply resumes from the checkpoints instead of copying again. Check completed, e.g. in a check block or in the pipeline, " +
+					"to catch an incomplete copy. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"progress": schema.MapNestedAttribute{
+				Description: "The checkpoint of each namespace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"copied": schema.Int64Attribute{
+							Description: "The number of vectors copied.",
+							Computed:    true,
+						},
+						"next_token": schema.StringAttribute{
+							Description: "The pagination token of the source to resume at.",
+							Computed:    true,
+						},
+						"done": schema.BoolAttribute{
+							Description: "Whether every vector of the namespace was copied.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"completed": schema.BoolAttribute{
+				Description: "Whether every namespace was copied and its vector count verified.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the batching settings.
+func (r *indexCopyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config indexCopyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.BatchSize.IsNull() && !config.BatchSize.IsUnknown() {
+		if v := config.BatchSize.ValueInt64(); v < 1 || v > maxUpsertBatchSize {
+			resp.Diagnostics.AddAttributeError(path.Root("batch_size"), "Invalid batch size",
+				fmt.Sprintf("batch_size must be between 1 and %d, got %d.", maxUpsertBatchSize, v))
+		}
+	}
+	if !config.Parallelism.IsNull() && !config.Parallelism.IsUnknown() {
+		if v := config.Parallelism.ValueInt64(); v < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("parallelism"), "Invalid parallelism",
+				fmt.Sprintf("parallelism must be at least 1, got %d.", v))
+		}
+	}
+}
+
+// ModifyPlan plans to resume a copy that did not complete.
+func (r *indexCopyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var completed types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("completed"), &completed)...)
+	if resp.Diagnostics.HasError() || completed.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("completed"), types.BoolUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("progress"), types.MapUnknown(types.ObjectType{AttrTypes: copyProgressAttributeTypes}))...)
+}
+
+// sourceClient returns the client to read the source index with.
+func (r *indexCopyResource) sourceClient(ctx context.Context, model indexCopyResourceModel) (PineconeClientInterface, error) {
+	if model.Source.IsNull() {
+		return r.client, nil
+	}
+
+	var source indexCopySourceModel
+	if diags := model.Source.As(ctx, &source, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, fmt.Errorf("error: invalid source")
+	}
+	if source.APIKey.IsNull() && source.Environment.IsNull() {
+		return r.client, nil
+	}
+
+	// Without an API key, the source is read with the provider's credentials.
+	apiKey := source.APIKey.ValueString()
+	environment := r.client.GetEnvironment()
+	if !source.Environment.IsNull() {
+		environment = source.Environment.ValueString()
+	}
+	return r.newClient(apiKey, environment)
+}
+
+// copyProgressFromState reads the checkpoints of a model.
+func copyProgressFromState(ctx context.Context, model indexCopyResourceModel) (map[string]*CopyProgress, diag.Diagnostics) {
+	progress := make(map[string]*CopyProgress)
+	if model.Progress.IsNull() || model.Progress.IsUnknown() {
+		return progress, nil
+	}
+
+	var checkpoints map[string]struct {
+		Copied    types.Int64  `tfsdk:"copied"`
+		NextToken types.String `tfsdk:"next_token"`
+		Done      types.Bool   `tfsdk:"done"`
+	}
+	diags := model.Progress.ElementsAs(ctx, &checkpoints, false)
+	for namespace, checkpoint := range checkpoints {
+		progress[namespace] = &CopyProgress{
+			Copied:    int(checkpoint.Copied.ValueInt64()),
+			NextToken: checkpoint.NextToken.ValueString(),
+			Done:      checkpoint.Done.ValueBool(),
+		}
+	}
+	return progress, diags
+}
+
+// newTFCopyProgress converts checkpoints to their Terraform map.
+func newTFCopyProgress(progress map[string]*CopyProgress) (types.Map, diag.Diagnostics) {
+	elements := make(map[string]attr.Value, len(progress))
+	for namespace, p := range progress {
+		elements[namespace] = types.ObjectValueMust(copyProgressAttributeTypes, map[string]attr.Value{
+			"copied":     types.Int64Value(int64(p.Copied)),
+			"next_token": types.StringValue(p.NextToken),
+			"done":       types.BoolValue(p.Done),
+		})
+	}
+	return types.MapValue(types.ObjectType{AttrTypes: copyProgressAttributeTypes}, elements)
+}
+
+// copy copies from the checkpoints of progress and records the new ones in
+// the model, even when it fails, so the next apply can resume.
+func (r *indexCopyResource) copy(ctx context.Context, model *indexCopyResourceModel, progress map[string]*CopyProgress) (diags diag.Diagnostics) {
+	model.ID = types.StringValue(model.SourceIndexName.ValueString() + "/" + model.DestinationIndexName.ValueString())
+	model.Completed = types.BoolValue(false)
+	defer func() {
+		progressValue, progressDiags := newTFCopyProgress(progress)
+		diags.Append(progressDiags...)
+		model.Progress = progressValue
+	}()
+
+	var namespaces []string
+	diags.Append(model.Namespaces.ElementsAs(ctx, &namespaces, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	copyReq := CopyRequest{
+		SourceIndexName:      model.SourceIndexName.ValueString(),
+		DestinationIndexName: model.DestinationIndexName.ValueString(),
+		Namespaces:           namespaces,
+		BatchSize:            int(model.BatchSize.ValueInt64()),
+		Parallelism:          int(model.Parallelism.ValueInt64()),
+	}
+
+	source, err := r.sourceClient(ctx, *model)
+	if err == nil {
+		namespaces, err = CopyIndex(ctx, source, r.client, copyReq, progress)
+	}
+	if err == nil {
+		err = VerifyCopy(ctx, source, r.client, copyReq, namespaces)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error copying index",
+			"Could not copy index, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	model.Completed = types.BoolValue(true)
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := make(map[string]*CopyProgress)
+	copyDiags := r.copy(ctx, &plan, progress)
+	switch {
+	case !copyDiags.HasError():
+		resp.Diagnostics.Append(copyDiags...)
+	case !copiedAny(progress):
+		// Nothing to resume from, so the copy starts over on the next apply.
+		resp.Diagnostics.Append(copyDiags...)
+		return
+	case plan.AllowPartial.ValueBool():
+		// Errors would taint the resource, and replacing it would copy from the start.
+		// Keep the checkpoints with completed=false instead, so the next plan resumes.
+		resp.Diagnostics.Append(copyDiagnostics(copyDiags, copyResumeNote, true)...)
+	default:
+		// The state is kept with the errors, so Terraform taints the copy and replaces it.
+		resp.Diagnostics.Append(copyDiagnostics(copyDiags,
+			"\n\nThe copy is tainted, so the next apply copies again from the start. "+
+				"Set allow_partial to resume from the progress saved in state instead.", false)...)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// copyResumeNote tells how an incomplete copy that is not tainted continues.
+const copyResumeNote = "\n\nThe progress so far is saved in state; apply again to resume."
+
+// copyDiagnostics appends a note on how the next apply continues to the errors of a copy,
+// and turns them into warnings if asWarnings is set.
+func copyDiagnostics(copyDiags diag.Diagnostics, note string, asWarnings bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range copyDiags {
+		switch {
+		case d.Severity() != diag.SeverityError:
+			diags.Append(d)
+		case asWarnings:
+			diags.AddWarning(d.Summary(), d.Detail()+note)
+		default:
+			diags.AddError(d.Summary(), d.Detail()+note)
+		}
+	}
+	return diags
+}
+
+// copiedAny reports whether any vector was copied.
+func copiedAny(progress map[string]*CopyProgress) bool {
+	for _, p := range progress {
+		if p.Copied > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Read checks the destination index still exists.
+func (r *indexCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.DescribeIndex(ctx, state.DestinationIndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Index Copy",
+			"Could not read Pinecone Index Copy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// If the destination is gone, then so are the copied vectors
+	if index == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// Update resumes an incomplete copy.
+func (r *indexCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan indexCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state indexCopyResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Completed.ValueBool() {
+		// Only the credentials or batching settings changed.
+		plan.Progress = state.Progress
+		plan.Completed = state.Completed
+	} else {
+		progress, diags := copyProgressFromState(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(copyDiagnostics(r.copy(ctx, &plan, progress), copyResumeNote, false)...)
+	}
+
+	// Set state even on error, to keep the checkpoints
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the copy from the Terraform state and leaves the copied vectors.
+func (r *indexCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexCopyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+	r.newClient = data.newClient
+}
@@ -0,0 +1,279 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIndexCopyResource(t *testing.T) {
+	source := newSeededMockClient(t, 120, "", "tenant-a")
+	destination := newSeededMockClient(t, 0)
+	_ = destination.CreateIndex(context.Background(), CreateIndexRequest{Name: "wide", Dimension: 3})
+
+	config := func(destinationIndexName string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "pinecone_index_copy" "test" {
+    source_index_name      = "test"
+    destination_index_name = %q
+    batch_size             = 25
+    parallelism            = 2
+
+    source = {
+        api_key     = "source_api_key"
+        environment = "us-west1-gcp"
+    }
+}
+`, destinationIndexName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClients(destination, map[string]PineconeClientInterface{
+			"source_api_key": source,
+		}),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "id", "test/test"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "completed", "true"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress.%", "2"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress.tenant-a.copied", "120"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress.tenant-a.done", "true"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress.tenant-a.next_token", ""),
+					func(s *terraform.State) error {
+						stats, err := destination.DescribeIndexStats(context.Background(), "test", DescribeIndexStatsRequest{})
+						if err != nil {
+							return err
+						}
+						if stats.TotalVectorCount != 240 {
+							return fmt.Errorf("expected 240 copied vectors, but found %d", stats.TotalVectorCount)
+						}
+						return nil
+					},
+				),
+			},
+			// The dimensions of the indexes must match
+			{
+				Config:      config("wide"),
+				ExpectError: regexp.MustCompile(`destination\s+index\s+wide\s+has\s+dimension\s+3`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIndexCopyResourceInvalidCredentials(t *testing.T) {
+	destination := newSeededMockClient(t, 0)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClients(destination, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pinecone_index_copy" "test" {
+    source_index_name      = "test"
+    destination_index_name = "test"
+
+    source = {
+        api_key = "unknown_api_key"
+    }
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid\s+API\s+key\s+for\s+environment\s+test`),
+			},
+		},
+	})
+}
+
+func TestAccIndexCopyResourceClientCredentials(t *testing.T) {
+	source := newSeededMockClient(t, 30, "")
+	destination := newSeededMockClient(t, 0)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClients(destination, map[string]PineconeClientInterface{
+			"oauth:id": source,
+		}),
+		Steps: []resource.TestStep{
+			// The source in another environment is read with the provider's OAuth credentials.
+			{
+				Config: `
+provider "pinecone" {
+    environment   = "test"
+    client_id     = "id"
+    client_secret = "secret"
+}
+
+resource "pinecone_index_copy" "test" {
+    source_index_name      = "test"
+    destination_index_name = "test"
+
+    source = {
+        environment = "us-west1-gcp"
+    }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "completed", "true"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress.%", "1"),
+					func(s *terraform.State) error {
+						stats, err := destination.DescribeIndexStats(context.Background(), "test", DescribeIndexStatsRequest{})
+						if err != nil {
+							return err
+						}
+						if stats.TotalVectorCount != 30 {
+							return fmt.Errorf("expected 30 copied vectors, but found %d", stats.TotalVectorCount)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestNewClientWithCredentials(t *testing.T) {
+	tokens := NewTokenSource("id", "secret")
+	cli, err := newClientWithCredentials("", tokens, "us-west1-gcp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client := cli.(*PineconeClient); client.Tokens != tokens || client.APIKey != "" || client.Environment != "us-west1-gcp" {
+		t.Fatalf("expected a client with the OAuth tokens, got %+v", client)
+	}
+
+	cli, err = newClientWithCredentials("source_api_key", nil, "us-west1-gcp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client := cli.(*PineconeClient); client.Tokens != nil || client.APIKey != "source_api_key" {
+		t.Fatalf("expected a client with the API key, got %+v", client)
+	}
+}
+
+func TestAccIndexCopyResourcePartialFailure(t *testing.T) {
+	source := newSeededMockClient(t, 120, "")
+	destination := &failingUpsertClient{MockPineconeClient: newSeededMockClient(t, 0), allowed: 2}
+
+	config := providerConfig + `
+resource "pinecone_index_copy" "test" {
+    source_index_name      = "test"
+    destination_index_name = "test"
+    batch_size             = 25
+    parallelism            = 1
+
+    source = {
+        api_key = "source_api_key"
+    }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClients(destination, map[string]PineconeClientInterface{
+			"source_api_key": source,
+		}),
+		Steps: []resource.TestStep{
+			// A copy that fails midway fails the apply, and is tainted.
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s)upsert\s+unavailable.*copies\s+again\s+from\s+the\s+start`),
+			},
+			// The next apply replaces it, copying from the start.
+			{
+				PreConfig: func() {
+					destination.allowed = 100
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_index_copy.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "completed", "true"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress..copied", "120"),
+					func(s *terraform.State) error {
+						if destination.allowed != 95 {
+							return fmt.Errorf("expected the copy to start over with 5 upserts, but it sent %d", 100-destination.allowed)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccIndexCopyResourceResume(t *testing.T) {
+	source := newSeededMockClient(t, 120, "")
+	destination := &failingUpsertClient{MockPineconeClient: newSeededMockClient(t, 0)}
+
+	config := providerConfig + `
+resource "pinecone_index_copy" "test" {
+    source_index_name      = "test"
+    destination_index_name = "test"
+    batch_size             = 25
+    parallelism            = 1
+    allow_partial          = true
+
+    source = {
+        api_key = "source_api_key"
+    }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClients(destination, map[string]PineconeClientInterface{
+			"source_api_key": source,
+		}),
+		Steps: []resource.TestStep{
+			// A copy that copies nothing fails.
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`upsert\s+unavailable`),
+			},
+			// A copy that fails midway keeps its checkpoints, and plans to resume.
+			{
+				PreConfig: func() {
+					destination.allowed = 2
+				},
+				Config:             config,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "completed", "false"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress.%", "1"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress..copied", "50"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress..done", "false"),
+				),
+			},
+			// The next apply resumes the copy in place.
+			{
+				PreConfig: func() {
+					destination.allowed = 100
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_index_copy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "completed", "true"),
+					resource.TestCheckResourceAttr("pinecone_index_copy.test", "progress..copied", "120"),
+					func(s *terraform.State) error {
+						if destination.allowed != 97 {
+							return fmt.Errorf("expected the copy to resume with 3 upserts, but it sent %d", 100-destination.allowed)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
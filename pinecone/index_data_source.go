@@ -21,7 +21,8 @@ func NewIndexDataSource() datasource.DataSource {
 
 // coffeesDataSource is the data source implementation.
 type indexDataSource struct {
-	client PineconeClientInterface
+	client  PineconeClientInterface
+	pricing *PricingCatalog
 }
 
 type indexDataSourceModel struct {
@@ -35,6 +36,8 @@ type indexDataSourceModel struct {
 	PodType        types.String `tfsdk:"pod_type"`
 	MetadataConfig types.Object `tfsdk:"metadata_config"`
 	Status         *indexStatus `tfsdk:"status"`
+
+	EstimatedMonthlyCostUSD types.Float64 `tfsdk:"estimated_monthly_cost_usd"`
 }
 
 type indexStatus struct {
@@ -91,7 +94,7 @@ func (d *indexDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 				Attributes: map[string]schema.Attribute{
-					"indexed": schema.ListAttribute{
+					"indexed": schema.SetAttribute{
 						Description: "The indexed fields of the index.",
 						Optional:    true,
 						Computed:    true,
@@ -99,6 +102,10 @@ func (d *indexDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"estimated_monthly_cost_usd": schema.Float64Attribute{
+				Description: "The estimated monthly cost of the index in USD, from its pod type, pods and replicas. Null when the pod type has no known price.",
+				Computed:    true,
+			},
 			"status": schema.SingleNestedAttribute{
 				Description: "The status of the index.",
 				Computed:    true,
@@ -153,6 +160,8 @@ func (d *indexDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(unrecognizedIndexValueWarnings(index)...)
+
 	state := indexDataSourceModel{
 		ID:        types.StringValue(data.Name.ValueString()), // Set a unique value for the ID field
 		Name:      types.StringValue(index.Database.Name),
@@ -168,6 +177,8 @@ func (d *indexDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			State: types.StringValue(index.Status.State),
 			Ready: types.BoolValue(index.Status.Ready),
 		},
+
+		EstimatedMonthlyCostUSD: newTFEstimatedMonthlyCost(d.pricing, index.Database.PodType, index.Database.Pods, index.Database.Replicas),
 	}
 
 	// Define MetadataConfig
@@ -188,12 +199,13 @@ func (d *indexDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(PineconeClientInterface)
+	data, ok := req.ProviderData.(*pineconeProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.pricing = data.pricing
 }
@@ -0,0 +1,338 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &indexExportResource{}
+	_ resource.ResourceWithConfigure      = &indexExportResource{}
+	_ resource.ResourceWithValidateConfig = &indexExportResource{}
+)
+
+// NewIndexExportResource is a helper function to simplify the provider implementation.
+func NewIndexExportResource() resource.Resource {
+	return &indexExportResource{}
+}
+
+// indexExportResource is the resource implementation.
+type indexExportResource struct {
+	client PineconeClientInterface
+}
+
+type indexExportResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	IndexName             types.String `tfsdk:"index_name"`
+	Namespaces            types.List   `tfsdk:"namespaces"`
+	Directory             types.String `tfsdk:"directory"`
+	BatchSize             types.Int64  `tfsdk:"batch_size"`
+	Parallelism           types.Int64  `tfsdk:"parallelism"`
+	Triggers              types.Map    `tfsdk:"triggers"`
+	ManifestPath          types.String `tfsdk:"manifest_path"`
+	ExportedAt            types.String `tfsdk:"exported_at"`
+	TotalVectorCount      types.Int64  `tfsdk:"total_vector_count"`
+	NamespaceVectorCounts types.Map    `tfsdk:"namespace_vector_counts"`
+	Checksums             types.Map    `tfsdk:"checksums"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_export"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Export the vectors of an index to a local directory: one JSONL file per namespace, in the format pinecone_vectors reads, " +
+			"and a manifest.json with the vector count and SHA-256 of each file. Listing vector IDs requires a serverless index. " +
+			"The files are kept on destroy; the export runs again when an argument or trigger changes or a file no longer matches its checksum.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the export, the path of its directory.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index to export.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespaces": schema.ListAttribute{
+				Description: "The namespaces to export. Defaults to every namespace of the index.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Description: "The directory to write the files to. It is created if missing, and existing files of the same names are overwritten.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "The number of vectors per fetch request.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(fetchBatchSize),
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: "The number of fetch requests to send at once.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultParallelism),
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that export the index again when they change.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_path": schema.StringAttribute{
+				Description: "The path of the manifest.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"exported_at": schema.StringAttribute{
+				Description: "The time of the export, in RFC 3339.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_vector_count": schema.Int64Attribute{
+				Description: "The number of exported vectors.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace_vector_counts": schema.MapAttribute{
+				Description: "The number of exported vectors of each namespace.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"checksums": schema.MapAttribute{
+				Description: "The SHA-256 of the file of each namespace.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the batching settings.
+func (r *indexExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config indexExportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.BatchSize.IsNull() && !config.BatchSize.IsUnknown() {
+		if v := config.BatchSize.ValueInt64(); v < 1 || v > deleteBatchSize {
+			resp.Diagnostics.AddAttributeError(path.Root("batch_size"), "Invalid batch size",
+				fmt.Sprintf("batch_size must be between 1 and %d, got %d.", deleteBatchSize, v))
+		}
+	}
+	if !config.Parallelism.IsNull() && !config.Parallelism.IsUnknown() {
+		if v := config.Parallelism.ValueInt64(); v < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("parallelism"), "Invalid parallelism",
+				fmt.Sprintf("parallelism must be at least 1, got %d.", v))
+		}
+	}
+}
+
+// setManifest sets the computed attributes of the model from a manifest.
+func (m *indexExportResourceModel) setManifest(ctx context.Context, manifest *ExportManifest) error {
+	counts := make(map[string]int64, len(manifest.Namespaces))
+	checksums := make(map[string]string, len(manifest.Namespaces))
+	for _, ns := range manifest.Namespaces {
+		counts[ns.Namespace] = int64(ns.VectorCount)
+		checksums[ns.Namespace] = ns.SHA256
+	}
+
+	countsValue, diags := types.MapValueFrom(ctx, types.Int64Type, counts)
+	if diags.HasError() {
+		return errors.New("error: invalid namespace_vector_counts")
+	}
+	checksumsValue, diags := types.MapValueFrom(ctx, types.StringType, checksums)
+	if diags.HasError() {
+		return errors.New("error: invalid checksums")
+	}
+
+	m.ID = types.StringValue(m.Directory.ValueString())
+	m.ManifestPath = types.StringValue(filepath.Join(m.Directory.ValueString(), ExportManifestFile))
+	m.ExportedAt = types.StringValue(manifest.ExportedAt)
+	m.TotalVectorCount = types.Int64Value(int64(manifest.TotalVectorCount))
+	m.NamespaceVectorCounts = countsValue
+	m.Checksums = checksumsValue
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexExportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var namespaces []string
+	diags = plan.Namespaces.ElementsAs(ctx, &namespaces, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := ExportIndex(ctx, r.client, ExportRequest{
+		IndexName:   plan.IndexName.ValueString(),
+		Namespaces:  namespaces,
+		Directory:   plan.Directory.ValueString(),
+		BatchSize:   int(plan.BatchSize.ValueInt64()),
+		Parallelism: int(plan.Parallelism.ValueInt64()),
+	})
+	if err == nil {
+		err = plan.setManifest(ctx, manifest)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error exporting index",
+			"Could not export index, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read checks the exported files still match the manifest.
+func (r *indexExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexExportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := os.ReadFile(state.ManifestPath.ValueString())
+	if errors.Is(err, os.ErrNotExist) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Index Export",
+			"Could not read Pinecone Index Export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var manifest ExportManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Index Export",
+			"Could not read Pinecone Index Export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Export again if a file is gone or was changed.
+	for _, ns := range manifest.Namespaces {
+		checksum, err := fileSHA256(filepath.Join(state.Directory.ValueString(), ns.File))
+		if err != nil || checksum != ns.SHA256 {
+			resp.Diagnostics.AddWarning(
+				"Exported file changed",
+				fmt.Sprintf("%s no longer matches %s, so the index will be exported again.", ns.File, ExportManifestFile),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	if err := state.setManifest(ctx, &manifest); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Index Export",
+			"Could not read Pinecone Index Export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only changes the batching settings, which apply to the next export.
+func (r *indexExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan indexExportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the export from the Terraform state and keeps its files.
+func (r *indexExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+}
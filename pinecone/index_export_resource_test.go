@@ -0,0 +1,80 @@
+package pinecone
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIndexExportResource(t *testing.T) {
+	cli := newSeededMockClient(t, 5, "", "tenant-a")
+	dir := filepath.Join(t.TempDir(), "export")
+	config := func(namespaces string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "pinecone_index_export" "test" {
+    index_name  = "test"
+    directory   = %q
+    namespaces  = %s
+    batch_size  = 2
+    parallelism = 2
+}
+`, dir, namespaces)
+	}
+	file := filepath.Join(dir, "tenant-a.jsonl")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		// The exported files outlive the resource.
+		CheckDestroy: func(s *terraform.State) error {
+			_, err := os.Stat(filepath.Join(dir, ExportManifestFile))
+			return err
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "id", dir),
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "manifest_path", filepath.Join(dir, ExportManifestFile)),
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "total_vector_count", "10"),
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "namespace_vector_counts.%", "2"),
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "namespace_vector_counts.tenant-a", "5"),
+					resource.TestCheckResourceAttrSet("pinecone_index_export.test", "checksums.tenant-a"),
+					resource.TestCheckResourceAttrSet("pinecone_index_export.test", "exported_at"),
+				),
+			},
+			// A changed file is exported again
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(file, []byte("{}\n"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config("null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						checksum, err := fileSHA256(file)
+						if err != nil {
+							return err
+						}
+						return resource.TestCheckResourceAttr("pinecone_index_export.test", "checksums.tenant-a", checksum)(s)
+					},
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "total_vector_count", "10"),
+				),
+			},
+			// Changing the namespaces exports again
+			{
+				Config: config(`["tenant-a"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "total_vector_count", "5"),
+					resource.TestCheckResourceAttr("pinecone_index_export.test", "namespace_vector_counts.%", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
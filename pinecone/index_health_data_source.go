@@ -0,0 +1,300 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultHealthProbeCount = 5
+	maxHealthProbeCount     = 100
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &indexHealthDataSource{}
+	_ datasource.DataSourceWithConfigure      = &indexHealthDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &indexHealthDataSource{}
+)
+
+// NewIndexHealthDataSource is a helper function to simplify the provider implementation.
+func NewIndexHealthDataSource() datasource.DataSource {
+	return &indexHealthDataSource{}
+}
+
+// indexHealthDataSource is the data source implementation.
+type indexHealthDataSource struct {
+	client PineconeClientInterface
+}
+
+type indexHealthDataSourceModel struct {
+	ID              types.String  `tfsdk:"id"`
+	IndexName       types.String  `tfsdk:"index_name"`
+	Namespace       types.String  `tfsdk:"namespace"`
+	ProbeCount      types.Int64   `tfsdk:"probe_count"`
+	MinSuccessRate  types.Float64 `tfsdk:"min_success_rate"`
+	MaxP95LatencyMs types.Float64 `tfsdk:"max_p95_latency_ms"`
+	State           types.String  `tfsdk:"state"`
+	Ready           types.Bool    `tfsdk:"ready"`
+	Waiting         types.List    `tfsdk:"waiting"`
+	Crashed         types.List    `tfsdk:"crashed"`
+	ProbesSucceeded types.Int64   `tfsdk:"probes_succeeded"`
+	SuccessRate     types.Float64 `tfsdk:"success_rate"`
+	P50LatencyMs    types.Float64 `tfsdk:"p50_latency_ms"`
+	P95LatencyMs    types.Float64 `tfsdk:"p95_latency_ms"`
+	LastProbeError  types.String  `tfsdk:"last_probe_error"`
+	Healthy         types.Bool    `tfsdk:"healthy"`
+}
+
+// Metadata returns the data source type name.
+func (d *indexHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *indexHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Check the health of an index from its status and probe queries against its host. " +
+			"A missing index is reported as unhealthy rather than as an error.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the health check.",
+				Computed:    true,
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index.",
+				Required:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace to probe. Defaults to the default namespace \"\".",
+				Optional:    true,
+			},
+			"probe_count": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of probe queries to send, from 0 to %d. Defaults to %d.", maxHealthProbeCount, defaultHealthProbeCount),
+				Optional:    true,
+			},
+			"min_success_rate": schema.Float64Attribute{
+				Description: "The lowest share of successful probes, from 0 to 1, for the index to be healthy. Defaults to 1.",
+				Optional:    true,
+			},
+			"max_p95_latency_ms": schema.Float64Attribute{
+				Description: "The highest p95 probe latency in milliseconds for the index to be healthy. Unset means no limit.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the index, e.g. Ready. Null if the index does not exist.",
+				Computed:    true,
+			},
+			"ready": schema.BoolAttribute{
+				Description: "Whether the index is ready.",
+				Computed:    true,
+			},
+			"waiting": schema.ListAttribute{
+				Description: "The pods of the index that are waiting.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"crashed": schema.ListAttribute{
+				Description: "The pods of the index that have crashed.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"probes_succeeded": schema.Int64Attribute{
+				Description: "The number of probe queries that succeeded.",
+				Computed:    true,
+			},
+			"success_rate": schema.Float64Attribute{
+				Description: "The share of probe queries that succeeded, from 0 to 1. Null if no probes were sent.",
+				Computed:    true,
+			},
+			"p50_latency_ms": schema.Float64Attribute{
+				Description: "The median latency of the successful probes in milliseconds.",
+				Computed:    true,
+			},
+			"p95_latency_ms": schema.Float64Attribute{
+				Description: "The 95th percentile latency of the successful probes in milliseconds.",
+				Computed:    true,
+			},
+			"last_probe_error": schema.StringAttribute{
+				Description: "The error of the last failed probe, null if every probe succeeded.",
+				Computed:    true,
+			},
+			"healthy": schema.BoolAttribute{
+				Description: "Whether the index is ready with no crashed pods, and the probes meet min_success_rate and max_p95_latency_ms.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the probe settings are in range.
+func (d *indexHealthDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config indexHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ProbeCount.IsNull() && !config.ProbeCount.IsUnknown() {
+		if v := config.ProbeCount.ValueInt64(); v < 0 || v > maxHealthProbeCount {
+			resp.Diagnostics.AddAttributeError(path.Root("probe_count"), "Invalid probe_count",
+				fmt.Sprintf("probe_count must be between 0 and %d, got %d.", maxHealthProbeCount, v))
+		}
+	}
+	if !config.MinSuccessRate.IsNull() && !config.MinSuccessRate.IsUnknown() {
+		if v := config.MinSuccessRate.ValueFloat64(); v < 0 || v > 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("min_success_rate"), "Invalid min_success_rate",
+				fmt.Sprintf("min_success_rate must be between 0 and 1, got %g.", v))
+		}
+	}
+	if !config.MaxP95LatencyMs.IsNull() && !config.MaxP95LatencyMs.IsUnknown() {
+		if v := config.MaxP95LatencyMs.ValueFloat64(); v <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_p95_latency_ms"), "Invalid max_p95_latency_ms",
+				fmt.Sprintf("max_p95_latency_ms must be positive, got %g.", v))
+		}
+	}
+}
+
+// percentile returns the p-th percentile (0 < p <= 100) of the latencies by the nearest-rank method.
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// probeIndex sends probe queries one after another with a unit vector, and returns
+// the latencies of the successful ones and the error of the last failed one.
+func probeIndex(ctx context.Context, client DataPlaneClientInterface, index *DescribeIndexResponse, namespace string, count int) ([]time.Duration, error) {
+	vector := make([]float32, index.Database.Dimension)
+	if len(vector) > 0 {
+		vector[0] = 1
+	}
+
+	latencies := []time.Duration{}
+	var lastErr error
+	for i := 0; i < count; i++ {
+		start := time.Now()
+		_, err := client.Query(ctx, index.Database.Name, QueryRequest{Namespace: namespace, TopK: 1, Vector: vector})
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		latencies = append(latencies, time.Since(start))
+	}
+	return latencies, lastErr
+}
+
+// durationMs converts a duration to fractional milliseconds.
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *indexHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data indexHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := d.client.DescribeIndex(ctx, data.IndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error DescribeIndex", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(data.IndexName.ValueString())
+	data.State = types.StringNull()
+	data.Ready = types.BoolValue(false)
+	data.Waiting = types.ListValueMust(types.StringType, nil)
+	data.Crashed = types.ListValueMust(types.StringType, nil)
+	data.ProbesSucceeded = types.Int64Value(0)
+	data.SuccessRate = types.Float64Null()
+	data.P50LatencyMs = types.Float64Null()
+	data.P95LatencyMs = types.Float64Null()
+	data.LastProbeError = types.StringNull()
+	data.Healthy = types.BoolValue(false)
+
+	if index == nil {
+		diags := resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.State = types.StringValue(index.Status.State)
+	data.Ready = types.BoolValue(index.Status.Ready)
+	data.Waiting, diags = types.ListValueFrom(ctx, types.StringType, append([]string{}, index.Status.Waiting...))
+	resp.Diagnostics.Append(diags...)
+	data.Crashed, diags = types.ListValueFrom(ctx, types.StringType, append([]string{}, index.Status.Crashed...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	healthy := index.Status.Ready && len(index.Status.Crashed) == 0
+
+	probeCount := defaultHealthProbeCount
+	if !data.ProbeCount.IsNull() {
+		probeCount = int(data.ProbeCount.ValueInt64())
+	}
+	latencies, probeErr := probeIndex(ctx, d.client, index, data.Namespace.ValueString(), probeCount)
+	data.ProbesSucceeded = types.Int64Value(int64(len(latencies)))
+	if probeErr != nil {
+		data.LastProbeError = types.StringValue(probeErr.Error())
+	}
+	if probeCount > 0 {
+		successRate := float64(len(latencies)) / float64(probeCount)
+		data.SuccessRate = types.Float64Value(successRate)
+
+		minSuccessRate := 1.0
+		if !data.MinSuccessRate.IsNull() {
+			minSuccessRate = data.MinSuccessRate.ValueFloat64()
+		}
+		healthy = healthy && successRate >= minSuccessRate
+	}
+	if len(latencies) > 0 {
+		p95 := durationMs(percentile(latencies, 95))
+		data.P50LatencyMs = types.Float64Value(durationMs(percentile(latencies, 50)))
+		data.P95LatencyMs = types.Float64Value(p95)
+		if !data.MaxP95LatencyMs.IsNull() {
+			healthy = healthy && p95 <= data.MaxP95LatencyMs.ValueFloat64()
+		}
+	}
+	data.Healthy = types.BoolValue(healthy)
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *indexHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+
+	d.client = data.client
+}
@@ -0,0 +1,139 @@
+package pinecone
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// failingQueryClient fails every query.
+type failingQueryClient struct {
+	*MockPineconeClient
+}
+
+func (c *failingQueryClient) Query(ctx context.Context, indexName string, req QueryRequest) (*QueryResponse, error) {
+	return nil, errors.New("error: query failed")
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := []time.Duration{5, 1, 4, 2, 3, 10, 9, 8, 7, 6}
+	tests := []struct {
+		p        float64
+		expected time.Duration
+	}{
+		{50, 5},
+		{95, 10},
+		{10, 1},
+		{1, 1},
+		{100, 10},
+	}
+	for _, test := range tests {
+		if actual := percentile(latencies, test.p); actual != test.expected {
+			t.Fatalf("expected p%g to be %d, but received %d", test.p, test.expected, actual)
+		}
+	}
+}
+
+func TestAccIndexHealthDataSource(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_index_health" "test" {
+    index_name         = "test"
+    probe_count        = 3
+    max_p95_latency_ms = 10000
+}
+
+data "pinecone_index_health" "missing" {
+    index_name = "missing"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "id", "test"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "state", "Ready"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "ready", "true"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "crashed.#", "0"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "probes_succeeded", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "success_rate", "1"),
+					resource.TestCheckResourceAttrSet("data.pinecone_index_health.test", "p50_latency_ms"),
+					resource.TestCheckResourceAttrSet("data.pinecone_index_health.test", "p95_latency_ms"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "healthy", "true"),
+					resource.TestCheckNoResourceAttr("data.pinecone_index_health.test", "last_probe_error"),
+
+					resource.TestCheckNoResourceAttr("data.pinecone_index_health.missing", "state"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.missing", "ready", "false"),
+					resource.TestCheckNoResourceAttr("data.pinecone_index_health.missing", "success_rate"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.missing", "healthy", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIndexHealthDataSourceFailingProbes(t *testing.T) {
+	ctx := context.Background()
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = mock.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(&failingQueryClient{MockPineconeClient: mock}),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_index_health" "test" {
+    index_name = "test"
+}
+
+data "pinecone_index_health" "tolerant" {
+    index_name       = "test"
+    min_success_rate = 0
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "ready", "true"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "probes_succeeded", "0"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "success_rate", "0"),
+					resource.TestCheckNoResourceAttr("data.pinecone_index_health.test", "p50_latency_ms"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "last_probe_error", "error: query failed"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.test", "healthy", "false"),
+					resource.TestCheckResourceAttr("data.pinecone_index_health.tolerant", "healthy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIndexHealthDataSourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_index_health" "test" {
+    index_name         = "test"
+    probe_count        = 500
+    min_success_rate   = 2
+    max_p95_latency_ms = 0
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)probe_count\s+must\s+be\s+between\s+0\s+and\s+100.*min_success_rate\s+must\s+be\s+between\s+0\s+and\s+1.*max_p95_latency_ms\s+must\s+be\s+positive`),
+			},
+		},
+	})
+}
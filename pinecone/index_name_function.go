@@ -0,0 +1,57 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &indexNameFunction{}
+)
+
+// NewIndexNameFunction is a helper function to simplify the provider implementation.
+func NewIndexNameFunction() function.Function {
+	return &indexNameFunction{}
+}
+
+// indexNameFunction is the function implementation.
+type indexNameFunction struct{}
+
+// Metadata returns the function name.
+func (f *indexNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "index_name"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *indexNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize a string to a valid index name.",
+		Description: "Lowercase the string, replace every run of characters other than a-z and 0-9 with a single hyphen, trim leading and trailing hyphens and truncate to 45 characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The string to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the name.
+func (f *indexNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := NormalizeIndexName(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,44 @@
+package pinecone
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIndexNameFunction(t *testing.T) {
+	result, err := runFunction(NewIndexNameFunction(), types.StringUnknown(), types.StringValue("Search: Product Catalog (EU)"))
+	if err != nil {
+		t.Fatalf("expected no error, but received error: %v", err)
+	}
+	if !result.Equal(types.StringValue("search-product-catalog-eu")) {
+		t.Fatalf("expected search-product-catalog-eu, but received %v", result)
+	}
+
+	if _, err := runFunction(NewIndexNameFunction(), types.StringUnknown(), types.StringValue("!!!")); err == nil {
+		t.Fatal("expected an error for a name without alphanumeric characters")
+	}
+}
+
+func TestAccIndexNameFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+    value = provider::pinecone::index_name("Team A / Staging")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "team-a-staging"),
+				),
+			},
+		},
+	})
+}
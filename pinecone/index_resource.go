@@ -2,16 +2,21 @@ package pinecone
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,9 +24,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &indexResource{}
-	_ resource.ResourceWithConfigure   = &indexResource{}
-	_ resource.ResourceWithImportState = &indexResource{}
+	_ resource.Resource                   = &indexResource{}
+	_ resource.ResourceWithConfigure      = &indexResource{}
+	_ resource.ResourceWithImportState    = &indexResource{}
+	_ resource.ResourceWithModifyPlan     = &indexResource{}
+	_ resource.ResourceWithValidateConfig = &indexResource{}
 )
 
 // NewIndexResource is a helper function to simplify the provider implementation.
@@ -31,7 +38,11 @@ func NewIndexResource() resource.Resource {
 
 // indexResource is the resource implementation.
 type indexResource struct {
-	client PineconeClientInterface
+	client  PineconeClientInterface
+	policy  *IndexPolicy
+	pricing *PricingCatalog
+
+	costIncreaseWarningUSD *float64
 }
 
 type indexResourceModel struct {
@@ -43,31 +54,41 @@ type indexResourceModel struct {
 	Replicas       types.Int64  `tfsdk:"replicas"`
 	PodType        types.String `tfsdk:"pod_type"`
 	MetadataConfig types.Object `tfsdk:"metadata_config"`
+	Embed          types.Object `tfsdk:"embed"`
 	LastUpdated    types.String `tfsdk:"last_updated"`
+
+	EstimatedMonthlyCostUSD types.Float64 `tfsdk:"estimated_monthly_cost_usd"`
+}
+
+var metadataConfigAttributeTypes = map[string]attr.Type{
+	"indexed": types.SetType{
+		ElemType: types.StringType,
+	},
 }
 
 func NewTFMetadataConfig(metadataConfig *MetadataConfig) (types.Object, error) {
 	// Define the attribute types for the object
-	attributeTypes := map[string]attr.Type{
-		"indexed": types.ListType{
-			ElemType: types.StringType,
-		},
-	}
+	attributeTypes := metadataConfigAttributeTypes
 
 	if metadataConfig == nil {
 		return types.ObjectNull(attributeTypes), nil
 	}
 
-	indexed := make([]attr.Value, len(metadataConfig.Indexed))
-	for i, v := range metadataConfig.Indexed {
-		value := types.StringValue(v)
-		indexed[i] = value
+	// Pinecone may return the fields in any order and a set must not hold duplicates.
+	indexed := make([]attr.Value, 0, len(metadataConfig.Indexed))
+	seen := make(map[string]bool, len(metadataConfig.Indexed))
+	for _, v := range metadataConfig.Indexed {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		indexed = append(indexed, types.StringValue(v))
 	}
-	list_value, _ := types.ListValue(types.StringType, indexed)
+	set_value, _ := types.SetValue(types.StringType, indexed)
 
 	// Define the attributes for the object
 	attributes := map[string]attr.Value{
-		"indexed": list_value,
+		"indexed": set_value,
 	}
 
 	// Create the object
@@ -75,6 +96,207 @@ func NewTFMetadataConfig(metadataConfig *MetadataConfig) (types.Object, error) {
 	return object, nil
 }
 
+var embedAttributeTypes = map[string]attr.Type{
+	"model":            types.StringType,
+	"field_map":        types.MapType{ElemType: types.StringType},
+	"read_parameters":  types.MapType{ElemType: types.StringType},
+	"write_parameters": types.MapType{ElemType: types.StringType},
+	"cloud":            types.StringType,
+	"region":           types.StringType,
+}
+
+type embedModel struct {
+	Model           types.String `tfsdk:"model"`
+	FieldMap        types.Map    `tfsdk:"field_map"`
+	ReadParameters  types.Map    `tfsdk:"read_parameters"`
+	WriteParameters types.Map    `tfsdk:"write_parameters"`
+	Cloud           types.String `tfsdk:"cloud"`
+	Region          types.String `tfsdk:"region"`
+}
+
+// NewIndexEmbed returns the integrated inference config of an embed object, or nil if it is null.
+func NewIndexEmbed(ctx context.Context, object types.Object) (*IndexEmbed, error) {
+	if object.IsNull() || object.IsUnknown() {
+		return nil, nil
+	}
+
+	var model embedModel
+	if diags := object.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, fmt.Errorf("error: invalid embed")
+	}
+	embed := &IndexEmbed{
+		Model:    model.Model.ValueString(),
+		FieldMap: map[string]string{},
+	}
+	if diags := model.FieldMap.ElementsAs(ctx, &embed.FieldMap, false); diags.HasError() {
+		return nil, fmt.Errorf("error: invalid embed field_map")
+	}
+	var err error
+	if embed.ReadParameters, err = newAnyMap(ctx, model.ReadParameters); err != nil {
+		return nil, fmt.Errorf("error: invalid embed read_parameters")
+	}
+	if embed.WriteParameters, err = newAnyMap(ctx, model.WriteParameters); err != nil {
+		return nil, fmt.Errorf("error: invalid embed write_parameters")
+	}
+	return embed, nil
+}
+
+// NewCreateIndexForModelRequest returns the request creating an index for the embedding
+// model of an embed object, with the dimension and metric of item.
+func NewCreateIndexForModelRequest(ctx context.Context, item CreateIndexRequest, object types.Object) (*CreateIndexForModelRequest, error) {
+	embed, err := NewIndexEmbed(ctx, object)
+	if err != nil || embed == nil {
+		return nil, err
+	}
+	var model embedModel
+	if diags := object.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, fmt.Errorf("error: invalid embed")
+	}
+	return &CreateIndexForModelRequest{
+		Name:   item.Name,
+		Cloud:  model.Cloud.ValueString(),
+		Region: model.Region.ValueString(),
+		Embed: IndexForModelEmbed{
+			IndexEmbed: *embed,
+			Metric:     item.Metric,
+			Dimension:  item.Dimension,
+		},
+	}, nil
+}
+
+// newAnyMap returns the values of a map of strings as a JSON object, or nil if the map is null.
+func newAnyMap(ctx context.Context, value types.Map) (map[string]any, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var values map[string]string
+	if diags := value.ElementsAs(ctx, &values, false); diags.HasError() {
+		return nil, fmt.Errorf("error: invalid map of strings")
+	}
+	result := make(map[string]any, len(values))
+	for key, v := range values {
+		result[key] = v
+	}
+	return result, nil
+}
+
+// newTFStringMap returns a JSON object as a map of strings. Values that are not strings
+// are kept as JSON, and an empty object is null.
+func newTFStringMap(values map[string]any) (types.Map, error) {
+	if len(values) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		text, ok := value.(string)
+		if !ok {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return types.MapNull(types.StringType), err
+			}
+			text = string(data)
+		}
+		elements[key] = types.StringValue(text)
+	}
+	return types.MapValueMust(types.StringType, elements), nil
+}
+
+// NewTFIndexEmbed returns the embed object of an integrated inference config, on the cloud
+// and region of a serverless index. Parameters that are not strings are kept as JSON, and
+// no parameters are null.
+func NewTFIndexEmbed(embed *IndexEmbed, serverless *ServerlessSpec) (types.Object, error) {
+	if embed == nil {
+		return types.ObjectNull(embedAttributeTypes), nil
+	}
+
+	fieldMap := make(map[string]attr.Value, len(embed.FieldMap))
+	for key, value := range embed.FieldMap {
+		fieldMap[key] = types.StringValue(value)
+	}
+	readParameters, err := newTFStringMap(embed.ReadParameters)
+	if err != nil {
+		return types.ObjectNull(embedAttributeTypes), err
+	}
+	writeParameters, err := newTFStringMap(embed.WriteParameters)
+	if err != nil {
+		return types.ObjectNull(embedAttributeTypes), err
+	}
+	cloud, region := types.StringNull(), types.StringNull()
+	if serverless != nil {
+		cloud, region = types.StringValue(serverless.Cloud), types.StringValue(serverless.Region)
+	}
+
+	object, diags := types.ObjectValue(embedAttributeTypes, map[string]attr.Value{
+		"model":            types.StringValue(embed.Model),
+		"field_map":        types.MapValueMust(types.StringType, fieldMap),
+		"read_parameters":  readParameters,
+		"write_parameters": writeParameters,
+		"cloud":            cloud,
+		"region":           region,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(embedAttributeTypes), fmt.Errorf("error: invalid embed of model %s", embed.Model)
+	}
+	return object, nil
+}
+
+// embedRequiresReplace replaces the index when the embedding model, field map, cloud or
+// region changes, or when integrated inference is turned on or off, as ConfigureIndexRequest
+// can only change the read and write parameters. An imported index of unknown cloud and
+// region is not replaced.
+func embedRequiresReplace(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() != req.PlanValue.IsNull() {
+		resp.RequiresReplace = true
+		return
+	}
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned, state := req.PlanValue.Attributes(), req.StateValue.Attributes()
+	resp.RequiresReplace = !planned["model"].Equal(state["model"]) || !planned["field_map"].Equal(state["field_map"])
+	for _, name := range []string{"cloud", "region"} {
+		if !state[name].IsNull() && !planned[name].Equal(state[name]) {
+			resp.RequiresReplace = true
+		}
+	}
+}
+
+// metadataConfigRequiresReplace replaces the index when the configured indexed fields
+// differ from Pinecone's, as ConfigureIndexRequest cannot change them.
+func metadataConfigRequiresReplace(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if indexed, ok := req.PlanValue.Attributes()["indexed"]; !ok || indexed.IsUnknown() {
+		return
+	}
+
+	planned, err := NewMetadataConfig(req.PlanValue)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid metadata config", err.Error())
+		return
+	}
+	current, err := NewMetadataConfig(req.StateValue)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid metadata config", err.Error())
+		return
+	}
+	if planned.Equal(current) {
+		return
+	}
+
+	resp.RequiresReplace = true
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Index will be replaced",
+		"Pinecone cannot change the indexed metadata fields of an existing index, so the index will be deleted "+
+			"and created again with the new fields. All vectors in the index will be lost.",
+	)
+}
+
 // Metadata returns the resource type name.
 func (r *indexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_index"
@@ -97,15 +319,16 @@ func (r *indexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"dimension": schema.Int64Attribute{
-				Description: "The dimension of the index.",
-				Required:    true,
+				Description: "The dimension of the index. Required unless embed is set, which defaults it to the dimension of the model.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
 				},
 			},
 			"metric": schema.StringAttribute{
-				Description: "The metric of the index.",
+				Description: "The metric of the index. Defaults to cosine, or to the default metric of the model of embed.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("cosine"),
@@ -115,33 +338,38 @@ func (r *indexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"pods": schema.Int64Attribute{
-				Description: "The number of pods of the index.",
+				Description: "The number of pods of the index. Defaults to 1, and is null when embed is set, as the index is then serverless.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(1),
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
 				},
 			},
 			"replicas": schema.Int64Attribute{
-				Description: "The number of replicas of the index.",
+				Description: "The number of replicas of the index. Defaults to 1, and is null when embed is set.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(1),
 			},
 			"pod_type": schema.StringAttribute{
-				Description: "The pod type of the index.",
+				Description: "The pod type of the index. Defaults to p1.x1, and is null when embed is set.",
 				Optional:    true,
 				Computed:    true,
-				Default:     stringdefault.StaticString("p1.x1"),
 			},
 			"metadata_config": schema.SingleNestedAttribute{
-				Description: "The metadata config of the index.",
+				Description: "The metadata config of the index. Pinecone cannot change it in place, so changing it replaces the index.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+					objectplanmodifier.RequiresReplaceIf(
+						metadataConfigRequiresReplace,
+						"Changing the indexed fields replaces the index.",
+						"Changing the indexed fields replaces the index.",
+					),
+				},
 				Attributes: map[string]schema.Attribute{
-					"indexed": schema.ListAttribute{
+					"indexed": schema.SetAttribute{
 						Description: "The indexed fields of the index.",
 						Optional:    true,
 						Computed:    true,
@@ -149,49 +377,410 @@ func (r *indexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			"embed": schema.SingleNestedAttribute{
+				Description: "Tie the index to an embedding model hosted by Pinecone, which then embeds the text of records " +
+					"upserted and queried. The index is created as a serverless index on cloud and region. " +
+					"Changing the model, field_map, cloud or region replaces the index.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						embedRequiresReplace,
+						"Changing the embedding model, field map, cloud or region replaces the index.",
+						"Changing the embedding model, field map, cloud or region replaces the index.",
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"model": schema.StringAttribute{
+						Description: fmt.Sprintf("The embedding model, one of %s.", strings.Join(embeddingModelNames(), ", ")),
+						Required:    true,
+					},
+					"field_map": schema.MapAttribute{
+						Description: "Maps the input of the model to the record field to embed, e.g. { text = \"chunk_text\" }.",
+						Required:    true,
+						ElementType: types.StringType,
+					},
+					"read_parameters": schema.MapAttribute{
+						Description: "Parameters of the model when embedding queries, e.g. { input_type = \"query\", truncate = \"END\" }.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"write_parameters": schema.MapAttribute{
+						Description: "Parameters of the model when embedding records, e.g. { input_type = \"passage\" }.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"cloud": schema.StringAttribute{
+						Description: "The cloud of the serverless index, aws, gcp or azure. Defaults to aws.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("aws"),
+					},
+					"region": schema.StringAttribute{
+						Description: "The region of the serverless index, e.g. us-east-1. Defaults to us-east-1.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("us-east-1"),
+					},
+				},
+			},
 			"last_updated": schema.StringAttribute{
 				Description: "The last updated time of the index.",
 				Computed:    true,
 			},
+			"estimated_monthly_cost_usd": schema.Float64Attribute{
+				Description: "The estimated monthly cost of the index in USD, from its pod type, pods and replicas. " +
+					"Null when the pod type has no known price, or when embed is set.",
+				Computed: true,
+			},
 		},
 	}
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Retrieve values from plan
+// ValidateConfig checks that the index has a dimension, and that the embedding model
+// is known and fits the dimension and metric of the index, which then has no pods.
+func (r *indexResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config indexResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Embed.IsNull() {
+		if config.Dimension.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("dimension"), "Missing dimension",
+				"dimension is required unless embed is set.")
+		}
+		return
+	}
+	if config.Embed.IsUnknown() {
+		return
+	}
+
+	podAttributes := map[string]attr.Value{"pods": config.Pods, "replicas": config.Replicas, "pod_type": config.PodType}
+	for _, name := range []string{"pods", "replicas", "pod_type"} {
+		if !podAttributes[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid attribute combination",
+				name+" cannot be set with embed, as an index with embed is serverless and has no pods.")
+		}
+	}
+
+	var embed embedModel
+	resp.Diagnostics.Append(config.Embed.As(ctx, &embed, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || embed.Model.IsUnknown() {
+		return
+	}
+	model, err := LookupEmbeddingModel(embed.Model.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("embed").AtName("model"), "Invalid embedding model", err.Error())
+		return
+	}
+	if !config.Dimension.IsNull() && !config.Dimension.IsUnknown() {
+		if err := model.CheckDimension(int(config.Dimension.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("dimension"), "Invalid dimension", err.Error())
+		}
+	}
+	if !config.Metric.IsNull() && !config.Metric.IsUnknown() {
+		if err := model.CheckMetric(Metric(config.Metric.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("metric"), "Invalid metric", err.Error())
+		}
+	}
+	if !embed.FieldMap.IsUnknown() {
+		text, ok := embed.FieldMap.Elements()["text"].(types.String)
+		if !ok || (!text.IsUnknown() && text.ValueString() == "") {
+			resp.Diagnostics.AddAttributeError(path.Root("embed").AtName("field_map"), "Invalid field map",
+				"field_map must map text to the record field to embed, e.g. { text = \"chunk_text\" }.")
+		}
+	}
+}
+
+// planEmbedDefaults sets the dimension and metric of a new index with embed to those of the
+// model, unless they are configured. Existing indexes keep theirs unless embed replaces them.
+func planEmbedDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan *indexResourceModel, state *indexResourceModel) {
+	if plan.Embed.IsNull() || plan.Embed.IsUnknown() {
+		return
+	}
+	modelName, ok := plan.Embed.Attributes()["model"].(types.String)
+	if !ok || modelName.IsUnknown() {
+		return
+	}
+	model, err := LookupEmbeddingModel(modelName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("embed").AtName("model"), "Invalid embedding model", err.Error())
+		return
+	}
+
+	if plan.Dimension.IsUnknown() {
+		plan.Dimension = types.Int64Value(int64(model.DefaultDimension))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dimension"), plan.Dimension)...)
+	}
+
+	var configMetric types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric"), &configMetric)...)
+	if configMetric.IsNull() && plan.Metric.ValueString() != model.DefaultMetric.String() {
+		plan.Metric = types.StringValue(model.DefaultMetric.String())
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("metric"), plan.Metric)...)
+		if state != nil && !plan.Metric.Equal(state.Metric) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("metric"))
+		}
+	}
+}
+
+// planPodDefaults defaults the pod attributes that are not configured: to a single p1.x1 pod
+// for a pod index, and to null for an index with embed, which is serverless. Like a changed
+// number of pods, a changed default replaces the index.
+func planPodDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan *indexResourceModel, state *indexResourceModel) {
+	if plan.Embed.IsUnknown() {
+		return
+	}
+	var config indexResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverless := !plan.Embed.IsNull()
+	if config.Pods.IsNull() {
+		plan.Pods = types.Int64Value(1)
+		if serverless {
+			plan.Pods = types.Int64Null()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pods"), plan.Pods)...)
+		if state != nil && !plan.Pods.Equal(state.Pods) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("pods"))
+		}
+	}
+	if config.Replicas.IsNull() {
+		plan.Replicas = types.Int64Value(1)
+		if serverless {
+			plan.Replicas = types.Int64Null()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("replicas"), plan.Replicas)...)
+	}
+	if config.PodType.IsNull() {
+		plan.PodType = types.StringValue("p1.x1")
+		if serverless {
+			plan.PodType = types.StringNull()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pod_type"), plan.PodType)...)
+	}
+}
+
+// ModifyPlan estimates the planned cost of the index and fails the plan when
+// the index breaks the provider's cost guardrails.
+func (r *indexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
 	var plan indexResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Generate API request body from plan
-	metric, err := NewMetric(plan.Metric.ValueString())
+	var state *indexResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &indexResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	planEmbedDefaults(ctx, req, resp, &plan, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planPodDefaults(ctx, req, resp, &plan, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Metric.IsUnknown() {
+		if err := validatePlannedMetric(plan.Metric, state); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("metric"), "Invalid metric", err.Error())
+			return
+		}
+	}
+
+	// Values known only after apply are checked on the next plan.
+	if plan.PodType.IsUnknown() || plan.Pods.IsUnknown() || plan.Replicas.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	// An index with embed is serverless: it has no pods to price or to check against the policy.
+	if !plan.Embed.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("estimated_monthly_cost_usd"), types.Float64Null())...)
+		return
+	}
+
+	podType, err := parsePlannedPodType(plan.PodType, state)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating index",
-			"Could not create index, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.AddAttributeError(path.Root("pod_type"), "Invalid pod type", err.Error())
+		return
+	}
+	pods := int(plan.Pods.ValueInt64())
+	replicas := int(plan.Replicas.ValueInt64())
+
+	r.planEstimatedMonthlyCost(ctx, plan, state, podType, pods, replicas, resp)
+	if resp.Diagnostics.HasError() || r.policy.IsEmpty() {
 		return
 	}
-	podType, err := NewPodType(plan.PodType.ValueString())
+
+	violations := r.policy.Check(podType, pods, replicas)
+
+	// The index being planned replaces itself, so leave its current pods out of the total.
+	exclude := []string{plan.Name.ValueString()}
+	if state != nil {
+		exclude = append(exclude, state.Name.ValueString())
+	}
+	totalViolations, err := r.policy.CheckTotal(ctx, r.client, pods, replicas, exclude...)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating index",
-			"Could not create index, unexpected error: "+err.Error(),
+			"Error checking index policy",
+			"Could not sum pods of existing indexes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	violations = append(violations, totalViolations...)
+
+	for _, v := range violations {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(v.Attribute),
+			"Policy violation",
+			fmt.Sprintf("Index %s violates the provider policy: %s.", plan.Name.ValueString(), v.Message),
+		)
+	}
+}
+
+// validatePlannedMetric validates a planned metric. A metric equal to the one in
+// state was reported by Pinecone and is accepted even if this provider does not recognize it.
+func validatePlannedMetric(planned types.String, state *indexResourceModel) error {
+	if state != nil && planned.Equal(state.Metric) {
+		return nil
+	}
+	_, err := NewMetric(planned.ValueString())
+	return err
+}
+
+// parsePlannedPodType validates a planned pod type. A pod type equal to the one in
+// state was reported by Pinecone and is accepted even if this provider does not recognize it.
+func parsePlannedPodType(planned types.String, state *indexResourceModel) (PodType, error) {
+	if state != nil && planned.Equal(state.PodType) {
+		return ParsePodType(planned.ValueString()), nil
+	}
+	return NewPodType(planned.ValueString())
+}
+
+// unrecognizedIndexValueWarnings warns about values Pinecone reported for an
+// index that this provider does not know; they are kept as-is.
+func unrecognizedIndexValueWarnings(index *DescribeIndexResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !index.Database.Metric.IsKnown() {
+		diags.AddAttributeWarning(
+			path.Root("metric"),
+			"Unrecognized metric",
+			fmt.Sprintf("Pinecone reported metric %q for index %s, which this provider version does not recognize. "+
+				"The value is kept as-is; upgrade the provider to validate it.", index.Database.Metric, index.Database.Name),
+		)
+	}
+	if index.Database.Serverless == nil && !index.Database.PodType.IsKnown() {
+		diags.AddAttributeWarning(
+			path.Root("pod_type"),
+			"Unrecognized pod type",
+			fmt.Sprintf("Pinecone reported pod type %q for index %s, which this provider version does not recognize. "+
+				"The value is kept as-is; upgrade the provider to validate it.", index.Database.PodType, index.Database.Name),
+		)
+	}
+	return diags
+}
+
+// metadataConfigDriftWarnings warns when the indexed fields Pinecone reports differ from the ones in state.
+func metadataConfigDriftWarnings(prior types.Object, remote *MetadataConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if prior.IsNull() || prior.IsUnknown() {
+		return diags
+	}
+
+	current, err := NewMetadataConfig(prior)
+	if err != nil || current.Equal(remote) {
+		return diags
+	}
+
+	var remoteIndexed []string
+	if remote != nil {
+		remoteIndexed = remote.Indexed
+	}
+	diags.AddAttributeWarning(
+		path.Root("metadata_config").AtName("indexed"),
+		"Indexed metadata fields changed outside Terraform",
+		fmt.Sprintf("Terraform state has indexed fields %v, but Pinecone reports %v. "+
+			"If the configuration still lists the old fields, the next apply replaces the index.", current.Indexed, remoteIndexed),
+	)
+	return diags
+}
+
+// planEstimatedMonthlyCost sets the planned estimated_monthly_cost_usd and warns when
+// the change raises it by more than cost_increase_warning_usd.
+func (r *indexResource) planEstimatedMonthlyCost(ctx context.Context, plan indexResourceModel, state *indexResourceModel, podType PodType, pods int, replicas int, resp *resource.ModifyPlanResponse) {
+	planned := newTFEstimatedMonthlyCost(r.pricing, podType, pods, replicas)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("estimated_monthly_cost_usd"), planned)...)
+
+	if r.costIncreaseWarningUSD == nil || planned.IsNull() {
+		return
+	}
+
+	var current float64
+	if state != nil {
+		current = state.EstimatedMonthlyCostUSD.ValueFloat64()
+	}
+	increase := planned.ValueFloat64() - current
+	if increase > *r.costIncreaseWarningUSD {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("estimated_monthly_cost_usd"),
+			"Estimated cost increase",
+			fmt.Sprintf("Index %s is estimated to cost $%.2f per month, up $%.2f from $%.2f, which is more than cost_increase_warning_usd of $%.2f.",
+				plan.Name.ValueString(), planned.ValueFloat64(), increase, current, *r.costIncreaseWarningUSD),
 		)
+	}
+}
+
+// setTFPodAttributes sets the pod attributes and estimated cost of an index described by Pinecone.
+// A serverless index has no pods, so they are null.
+func setTFPodAttributes(model *indexResourceModel, database DescribeDatabaseResponse, pricing *PricingCatalog) {
+	if database.Serverless != nil {
+		model.Pods = types.Int64Null()
+		model.Replicas = types.Int64Null()
+		model.PodType = types.StringNull()
+		model.EstimatedMonthlyCostUSD = types.Float64Null()
+		return
+	}
+	model.Pods = types.Int64Value(int64(database.Pods))
+	model.Replicas = types.Int64Value(int64(database.Replicas))
+	model.PodType = types.StringValue(database.PodType.String())
+	model.EstimatedMonthlyCostUSD = newTFEstimatedMonthlyCost(pricing, database.PodType, database.Pods, database.Replicas)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Generate API request body from plan. ModifyPlan validated the metric and pod type,
+	// so they are converted without validating them again, as in Update.
 	item := CreateIndexRequest{
 		Name:      plan.Name.ValueString(),
 		Dimension: int(plan.Dimension.ValueInt64()),
-		Metric:    metric,
+		Metric:    Metric(plan.Metric.ValueString()),
 		Replicas:  int(plan.Replicas.ValueInt64()),
 		Pods:      int(plan.Pods.ValueInt64()),
-		PodType:   podType,
+		PodType:   ParsePodType(plan.PodType.ValueString()),
 	}
 
 	metadataConfig, err := NewMetadataConfig(plan.MetadataConfig)
@@ -204,8 +793,13 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	item.MetadataConfig = metadataConfig
 
-	// Create new order
-	err = r.client.CreateIndex(ctx, item)
+	// Indexes with integrated inference are serverless, and created for their model on the control plane.
+	forModel, err := NewCreateIndexForModelRequest(ctx, item, plan.Embed)
+	if err == nil && forModel != nil {
+		err = r.client.CreateIndexForModel(ctx, *forModel)
+	} else if err == nil {
+		err = r.client.CreateIndex(ctx, item)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating index",
@@ -214,11 +808,9 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	var done bool
 	var result *DescribeIndexResponse
-	for !done {
-		result, err = r.client.DescribeIndex(ctx, plan.Name.ValueString())
-
+	if forModel != nil {
+		result, err = WaitForIndexForModel(ctx, r.client, plan.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating index",
@@ -226,10 +818,23 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 			)
 			return
 		}
-		if result != nil && result.Status.Ready {
-			done = true
+	} else {
+		var done bool
+		for !done {
+			result, err = r.client.DescribeIndex(ctx, plan.Name.ValueString())
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error creating index",
+					"Could not create index, unexpected error: "+err.Error(),
+				)
+				return
+			}
+			if result != nil && result.Status.Ready {
+				done = true
+			}
+			time.Sleep(5 * time.Second)
 		}
-		time.Sleep(5 * time.Second)
 	}
 
 	if result == nil {
@@ -239,6 +844,7 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 		)
 		return
 	}
+	resp.Diagnostics.Append(unrecognizedIndexValueWarnings(result)...)
 
 	// Map response body to schema and populate Computed attribute values
 	plan = indexResourceModel{
@@ -246,10 +852,8 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 		Name:      types.StringValue(result.Database.Name),
 		Dimension: types.Int64Value(int64(result.Database.Dimension)),
 		Metric:    types.StringValue(result.Database.Metric.String()),
-		Pods:      types.Int64Value(int64(result.Database.Pods)),
-		Replicas:  types.Int64Value(int64(result.Database.Replicas)),
-		PodType:   types.StringValue(result.Database.PodType.String()),
 	}
+	setTFPodAttributes(&plan, result.Database, r.pricing)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	planTFMetadataConfig, err := NewTFMetadataConfig(result.Database.MetadataConfig)
 	if err != nil {
@@ -260,6 +864,14 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	plan.MetadataConfig = planTFMetadataConfig
+	plan.Embed, err = NewTFIndexEmbed(result.Database.Embed, result.Database.Serverless)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating index",
+			"Could not create index, unexpected error: "+err.Error(),
+		)
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -279,8 +891,12 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Get refreshed index value from Pinecone
-	index, err := r.client.DescribeIndex(ctx, state.Name.ValueString())
+	// Get refreshed index value from Pinecone. Indexes with embed were created on the control plane.
+	describeIndex := r.client.DescribeIndex
+	if !state.Embed.IsNull() {
+		describeIndex = r.client.DescribeServerlessIndex
+	}
+	index, err := describeIndex(ctx, state.Name.ValueString())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -295,6 +911,10 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	resp.Diagnostics.Append(unrecognizedIndexValueWarnings(index)...)
+
+	// Pinecone cannot change the indexed fields in place, so a difference means someone changed the index outside Terraform.
+	resp.Diagnostics.Append(metadataConfigDriftWarnings(state.MetadataConfig, index.Database.MetadataConfig)...)
 
 	// Overwrite items with refreshed state
 	state = indexResourceModel{
@@ -302,10 +922,8 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		Name:      types.StringValue(index.Database.Name),
 		Dimension: types.Int64Value(int64(index.Database.Dimension)),
 		Metric:    types.StringValue(index.Database.Metric.String()),
-		Pods:      types.Int64Value(int64(index.Database.Pods)),
-		Replicas:  types.Int64Value(int64(index.Database.Replicas)),
-		PodType:   types.StringValue(index.Database.PodType.String()),
 	}
+	setTFPodAttributes(&state, index.Database, r.pricing)
 	stateTFMetadataConfig, err := NewTFMetadataConfig(index.Database.MetadataConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -315,6 +933,14 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 	state.MetadataConfig = stateTFMetadataConfig
+	state.Embed, err = NewTFIndexEmbed(index.Database.Embed, index.Database.Serverless)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Index",
+			"Could not read Pinecone Index, unexpected error: "+err.Error(),
+		)
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -334,22 +960,29 @@ func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	podType, err := NewPodType(plan.PodType.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating index",
-			"Could not update index, unexpected error: "+err.Error(),
-		)
+	var state indexResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Generate API request body from plan
-	indexItem := ConfigureIndexRequest{
-		Replicas: int(plan.Replicas.ValueInt64()),
-		PodType:  podType,
+	// Generate API request body from plan. The pod type was validated when planning.
+	// An index with embed is serverless, so only the read and write parameters of embed change in place.
+	var indexItem ConfigureIndexRequest
+	if plan.Embed.IsNull() {
+		replicas := int(plan.Replicas.ValueInt64())
+		podType := ParsePodType(plan.PodType.ValueString())
+		indexItem.Replicas = &replicas
+		indexItem.PodType = &podType
+	}
+	embed, err := NewIndexEmbed(ctx, plan.Embed)
+	if err == nil && !plan.Embed.Equal(state.Embed) {
+		indexItem.Embed = embed
+	}
+	if err == nil && indexItem != (ConfigureIndexRequest{}) {
+		err = r.client.ConfigureIndex(ctx, plan.Name.ValueString(), indexItem)
 	}
-
-	err = r.client.ConfigureIndex(ctx, plan.Name.ValueString(), indexItem)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating index",
@@ -357,11 +990,9 @@ func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest,
 		)
 		return
 	}
-	var done bool
 	var result *DescribeIndexResponse
-	for !done {
-		result, err = r.client.DescribeIndex(ctx, plan.Name.ValueString())
-
+	if !plan.Embed.IsNull() {
+		result, err = WaitForIndexForModel(ctx, r.client, plan.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating index",
@@ -369,10 +1000,23 @@ func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest,
 			)
 			return
 		}
-		if result != nil && result.Status.Ready {
-			done = true
+	} else {
+		var done bool
+		for !done {
+			result, err = r.client.DescribeIndex(ctx, plan.Name.ValueString())
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating index",
+					"Could not update index, unexpected error: "+err.Error(),
+				)
+				return
+			}
+			if result != nil && result.Status.Ready {
+				done = true
+			}
+			time.Sleep(5 * time.Second)
 		}
-		time.Sleep(5 * time.Second)
 	}
 
 	if result == nil {
@@ -382,6 +1026,7 @@ func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest,
 		)
 		return
 	}
+	resp.Diagnostics.Append(unrecognizedIndexValueWarnings(result)...)
 
 	// Map response body to schema and populate Computed attribute values
 	plan = indexResourceModel{
@@ -389,10 +1034,8 @@ func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Name:      types.StringValue(result.Database.Name),
 		Dimension: types.Int64Value(int64(result.Database.Dimension)),
 		Metric:    types.StringValue(result.Database.Metric.String()),
-		Pods:      types.Int64Value(int64(result.Database.Pods)),
-		Replicas:  types.Int64Value(int64(result.Database.Replicas)),
-		PodType:   types.StringValue(result.Database.PodType.String()),
 	}
+	setTFPodAttributes(&plan, result.Database, r.pricing)
 
 	planTFMetadataConfig, err := NewTFMetadataConfig(result.Database.MetadataConfig)
 	if err != nil {
@@ -403,6 +1046,14 @@ func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	plan.MetadataConfig = planTFMetadataConfig
+	plan.Embed, err = NewTFIndexEmbed(result.Database.Embed, result.Database.Serverless)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating index",
+			"Could not update index, unexpected error: "+err.Error(),
+		)
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -457,12 +1108,15 @@ func (r *indexResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(PineconeClientInterface)
+	data, ok := req.ProviderData.(*pineconeProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
 		return
 	}
-	r.client = client
+	r.client = data.client
+	r.policy = data.policy
+	r.pricing = data.pricing
+	r.costIncreaseWarningUSD = data.costIncreaseWarningUSD
 }
 
 func (r *indexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -1,11 +1,14 @@
 package pinecone
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -49,7 +52,8 @@ resource "pinecone_index" "test" {
 					resource.TestCheckResourceAttr("pinecone_index.test", "pods", "1"),
 					resource.TestCheckResourceAttr("pinecone_index.test", "replicas", "1"),
 					resource.TestCheckResourceAttr("pinecone_index.test", "pod_type", "p1.x1"),
-					resource.TestCheckResourceAttr("pinecone_index.test", "metadata_config.indexed.0", "potato"),
+					resource.TestCheckTypeSetElemAttr("pinecone_index.test", "metadata_config.indexed.*", "potato"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "estimated_monthly_cost_usd", "70.08"),
 				),
 			},
 			// ImportState testing
@@ -78,6 +82,7 @@ resource "pinecone_index" "test" {
 					resource.TestCheckResourceAttr("pinecone_index.test", "id", "test"),
 					resource.TestCheckResourceAttr("pinecone_index.test", "replicas", "2"),
 					resource.TestCheckResourceAttr("pinecone_index.test", "pod_type", "p1.x2"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "estimated_monthly_cost_usd", "280.32"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -113,3 +118,360 @@ resource "pinecone_index" "test" {
 		},
 	})
 }
+
+func TestAccIndexResourceUnrecognizedValues(t *testing.T) {
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// An index created with a metric and pod type newer than this provider.
+	err = cli.CreateIndex(context.Background(), CreateIndexRequest{
+		Name:      "future",
+		Dimension: 8,
+		Metric:    Metric("hamming"),
+		Pods:      1,
+		Replicas:  1,
+		PodType:   ParsePodType("p3.x1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(podType string, replicas int) string {
+		return providerConfig + fmt.Sprintf(`
+resource "pinecone_index" "test" {
+	name      = "future"
+	dimension = 8
+	metric    = "hamming"
+	pod_type  = %q
+	replicas  = %d
+}
+`, podType, replicas)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config:             config("p3.x1", 1),
+				ResourceName:       "pinecone_index.test",
+				ImportState:        true,
+				ImportStateId:      "future",
+				ImportStatePersist: true,
+			},
+			// The server values round-trip without a diff.
+			{
+				Config:   config("p3.x1", 1),
+				PlanOnly: true,
+			},
+			// Updates send the unrecognized pod type back verbatim.
+			{
+				Config: config("p3.x1", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "metric", "hamming"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "pod_type", "p3.x1"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "replicas", "2"),
+					resource.TestCheckNoResourceAttr("pinecone_index.test", "estimated_monthly_cost_usd"),
+				),
+			},
+			// New values from configuration are still validated.
+			{
+				Config:      config("p3.x2", 2),
+				ExpectError: regexp.MustCompile(`invalid pod class: p3`),
+			},
+		},
+	})
+}
+
+func TestAccIndexResourceMetadataConfigIndexed(t *testing.T) {
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(indexed string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "pinecone_index" "test" {
+	name            = "test"
+	dimension       = 8
+	metadata_config = {
+		indexed = %s
+	}
+}
+`, indexed)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["genre", "year"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("pinecone_index.test", "metadata_config.indexed.*", "genre"),
+					resource.TestCheckTypeSetElemAttr("pinecone_index.test", "metadata_config.indexed.*", "year"),
+				),
+			},
+			// Pinecone returning the fields in another order is not a change.
+			{
+				PreConfig: func() {
+					index, _ := cli.DescribeIndex(context.Background(), "test")
+					index.Database.MetadataConfig = &MetadataConfig{Indexed: []string{"year", "genre"}}
+				},
+				Config:   config(`["year", "genre"]`),
+				PlanOnly: true,
+			},
+			// Changing the fields replaces the index.
+			{
+				Config: config(`["genre", "author"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_index.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("pinecone_index.test", "metadata_config.indexed.*", "author"),
+				),
+			},
+			// Fields changed outside Terraform are detected and the configured fields restored.
+			{
+				PreConfig: func() {
+					index, _ := cli.DescribeIndex(context.Background(), "test")
+					index.Database.MetadataConfig = &MetadataConfig{Indexed: []string{"genre"}}
+				},
+				Config: config(`["genre", "author"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_index.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestIndexEmbedRoundTrip(t *testing.T) {
+	object, err := NewTFIndexEmbed(&IndexEmbed{
+		Model:           "llama-text-embed-v2",
+		FieldMap:        map[string]string{"text": "chunk_text"},
+		ReadParameters:  map[string]any{"input_type": "query", "dimension": 512.0},
+		WriteParameters: map[string]any{},
+	}, &ServerlessSpec{Cloud: "gcp", Region: "us-central1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !object.Attributes()["write_parameters"].IsNull() {
+		t.Fatalf("expected no write parameters to be null, got %s", object.Attributes()["write_parameters"])
+	}
+
+	embed, err := NewIndexEmbed(context.Background(), object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if embed.Model != "llama-text-embed-v2" || embed.FieldMap["text"] != "chunk_text" {
+		t.Fatalf("unexpected embed %+v", embed)
+	}
+	if embed.ReadParameters["input_type"] != "query" || embed.ReadParameters["dimension"] != "512" {
+		t.Fatalf("unexpected read parameters %+v", embed.ReadParameters)
+	}
+	if embed.WriteParameters != nil {
+		t.Fatalf("expected no write parameters, got %+v", embed.WriteParameters)
+	}
+
+	req, err := NewCreateIndexForModelRequest(context.Background(), CreateIndexRequest{Name: "docs", Dimension: 512, Metric: MetricCosine}, object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Name != "docs" || req.Cloud != "gcp" || req.Region != "us-central1" || req.Embed.Model != "llama-text-embed-v2" ||
+		req.Embed.Dimension != 512 || req.Embed.Metric != MetricCosine {
+		t.Fatalf("unexpected create for model request %+v", req)
+	}
+}
+
+func TestAccIndexResourceEmbedInvalid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name:   "Missing dimension",
+			config: `metric = "cosine"`,
+			err:    `dimension is required unless embed is set`,
+		},
+		{
+			name:   "Unknown model",
+			config: `embed = { model = "text-embedding-ada-002", field_map = { text = "chunk_text" } }`,
+			err:    `unknown embedding model "text-embedding-ada-002"`,
+		},
+		{
+			name: "Unsupported dimension",
+			config: `dimension = 1536
+	embed = { model = "llama-text-embed-v2", field_map = { text = "chunk_text" } }`,
+			err: `embedding model llama-text-embed-v2 has dimension 384 or 512 or 768 or\s+1024 or 2048, got 1536`,
+		},
+		{
+			name: "Unsupported metric",
+			config: `metric = "euclidean"
+	embed = { model = "llama-text-embed-v2", field_map = { text = "chunk_text" } }`,
+			err: `embedding model llama-text-embed-v2 supports metric cosine or\s+dotproduct, got euclidean`,
+		},
+		{
+			name: "Pods with embed",
+			config: `replicas = 2
+	embed = { model = "multilingual-e5-large", field_map = { text = "chunk_text" } }`,
+			err: `replicas cannot be set with embed`,
+		},
+		{
+			name:   "Field map without text",
+			config: `embed = { model = "multilingual-e5-large", field_map = { body = "chunk_text" } }`,
+			err:    `field_map must map text to the record field to embed`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "pinecone_index" "test" {
+	name = "test"
+	%s
+}
+`, tc.config),
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccIndexResourceEmbed(t *testing.T) {
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// The metric defaults to the one of the model.
+			{
+				Config: providerConfig + `
+resource "pinecone_index" "test" {
+	name      = "test"
+	dimension = 512
+	embed = {
+		model     = "llama-text-embed-v2"
+		field_map = { text = "chunk_text" }
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "dimension", "512"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "metric", "cosine"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.model", "llama-text-embed-v2"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.field_map.text", "chunk_text"),
+					resource.TestCheckNoResourceAttr("pinecone_index.test", "embed.read_parameters"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.cloud", "aws"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.region", "us-east-1"),
+					// The index is serverless, so it has no pods to price.
+					resource.TestCheckNoResourceAttr("pinecone_index.test", "pods"),
+					resource.TestCheckNoResourceAttr("pinecone_index.test", "replicas"),
+					resource.TestCheckNoResourceAttr("pinecone_index.test", "pod_type"),
+					resource.TestCheckNoResourceAttr("pinecone_index.test", "estimated_monthly_cost_usd"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "pinecone_index.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// The read and write parameters change in place.
+			{
+				Config: providerConfig + `
+resource "pinecone_index" "test" {
+	name      = "test"
+	dimension = 512
+	embed = {
+		model           = "llama-text-embed-v2"
+		field_map       = { text = "chunk_text" }
+		read_parameters = { input_type = "query", truncate = "END" }
+	}
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_index.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.read_parameters.input_type", "query"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.read_parameters.truncate", "END"),
+					func(s *terraform.State) error {
+						index, _ := cli.DescribeIndex(context.Background(), "test")
+						if index.Database.Embed.ReadParameters["truncate"] != "END" {
+							return fmt.Errorf("read parameters not updated: %v", index.Database.Embed.ReadParameters)
+						}
+						return nil
+					},
+				),
+			},
+			// Changing the model replaces the index with one of the dimension of the new model.
+			{
+				Config: providerConfig + `
+resource "pinecone_index" "test" {
+	name = "test"
+	embed = {
+		model     = "multilingual-e5-large"
+		field_map = { text = "chunk_text" }
+	}
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_index.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "dimension", "1024"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.model", "multilingual-e5-large"),
+				),
+			},
+			// Moving the serverless index replaces it.
+			{
+				Config: providerConfig + `
+resource "pinecone_index" "test" {
+	name = "test"
+	embed = {
+		model     = "multilingual-e5-large"
+		field_map = { text = "chunk_text" }
+		cloud     = "gcp"
+		region    = "us-central1"
+	}
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pinecone_index.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.cloud", "gcp"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.region", "us-central1"),
+					func(s *terraform.State) error {
+						index, _ := cli.DescribeIndex(context.Background(), "test")
+						if index.Database.Serverless == nil || index.Database.Serverless.Region != "us-central1" {
+							return fmt.Errorf("index not created in us-central1: %+v", index.Database.Serverless)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
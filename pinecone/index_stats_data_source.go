@@ -0,0 +1,153 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &indexStatsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &indexStatsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &indexStatsDataSource{}
+)
+
+// NewIndexStatsDataSource is a helper function to simplify the provider implementation.
+func NewIndexStatsDataSource() datasource.DataSource {
+	return &indexStatsDataSource{}
+}
+
+// indexStatsDataSource is the data source implementation.
+type indexStatsDataSource struct {
+	client PineconeClientInterface
+}
+
+type indexStatsDataSourceModel struct {
+	ID               types.String  `tfsdk:"id"`
+	IndexName        types.String  `tfsdk:"index_name"`
+	Filter           types.String  `tfsdk:"filter"`
+	Dimension        types.Int64   `tfsdk:"dimension"`
+	IndexFullness    types.Float64 `tfsdk:"index_fullness"`
+	TotalVectorCount types.Int64   `tfsdk:"total_vector_count"`
+	Namespaces       types.Map     `tfsdk:"namespaces"`
+}
+
+// Metadata returns the data source type name.
+func (d *indexStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_stats"
+}
+
+// Schema defines the schema for the data source.
+func (d *indexStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get vector counts of an index from its host.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the index stats.",
+				Computed:    true,
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index.",
+				Required:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "A JSON metadata filter, e.g. jsonencode({ genre = { \"$eq\" = \"drama\" } }) or provider::pinecone::metadata_filter({ ... }). Only vectors matching it are counted.",
+				Optional:    true,
+			},
+			"dimension": schema.Int64Attribute{
+				Description: "The dimension of the index.",
+				Computed:    true,
+			},
+			"index_fullness": schema.Float64Attribute{
+				Description: "How full the index is, from 0 to 1.",
+				Computed:    true,
+			},
+			"total_vector_count": schema.Int64Attribute{
+				Description: "The number of vectors in the index.",
+				Computed:    true,
+			},
+			"namespaces": schema.MapAttribute{
+				Description: "The number of vectors in each namespace. The default namespace is \"\".",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the filter when planning.
+func (d *indexStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var filter types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter"), &filter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := decodeFilter(filter); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid filter", err.Error())
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *indexStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data indexStatsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := decodeFilter(data.Filter)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid filter", err.Error())
+		return
+	}
+
+	stats, err := d.client.DescribeIndexStats(ctx, data.IndexName.ValueString(), DescribeIndexStatsRequest{Filter: filter})
+	if err != nil {
+		resp.Diagnostics.AddError("Error DescribeIndexStats", err.Error())
+		return
+	}
+
+	namespaces := make(map[string]int64, len(stats.Namespaces))
+	for name, summary := range stats.Namespaces {
+		namespaces[name] = int64(summary.VectorCount)
+	}
+	namespacesValue, diags := types.MapValueFrom(ctx, types.Int64Type, namespaces)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.IndexName.ValueString())
+	data.Dimension = types.Int64Value(int64(stats.Dimension))
+	data.IndexFullness = types.Float64Value(stats.IndexFullness)
+	data.TotalVectorCount = types.Int64Value(int64(stats.TotalVectorCount))
+	data.Namespaces = namespacesValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *indexStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+
+	d.client = data.client
+}
@@ -0,0 +1,68 @@
+package pinecone
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexStatsDataSource(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2})
+	_, _ = cli.Upsert(ctx, "test", UpsertRequest{Vectors: []Vector{
+		{ID: "a", Values: []float32{1, 0}, Metadata: map[string]any{"genre": "drama"}},
+		{ID: "b", Values: []float32{0, 1}, Metadata: map[string]any{"genre": "comedy"}},
+	}})
+	_, _ = cli.Upsert(ctx, "test", UpsertRequest{Namespace: "tenant-a", Vectors: []Vector{
+		{ID: "c", Values: []float32{1, 1}, Metadata: map[string]any{"genre": "drama"}},
+	}})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_index_stats" "all" {
+    index_name = "test"
+}
+
+data "pinecone_index_stats" "drama" {
+    index_name = "test"
+    filter     = jsonencode({ genre = { "$eq" = "drama" } })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.all", "id", "test"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.all", "dimension", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.all", "total_vector_count", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.all", "namespaces.%", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.all", "namespaces.tenant-a", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.drama", "total_vector_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIndexStatsDataSourceInvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_index_stats" "drama" {
+    index_name = "test"
+    filter     = jsonencode({ "$or" = [{ genre = { "$eq" = "drama" } }, { genre = { "$regex" = "com.*" } }] })
+}
+`,
+				ExpectError: regexp.MustCompile(`filter\.\$or\[1\]\.genre\.\$regex:\s+unknown\s+operator`),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// indexPollInterval is how often an index created for a model is checked while waiting for it.
+var indexPollInterval = 5 * time.Second
+
+// EmbedRequest embeds texts with a hosted embedding model.
+type EmbedRequest struct {
+	Model string `json:"model"`
+	// Parameters of the model, e.g. input_type, truncate and dimension.
+	Parameters map[string]any `json:"parameters,omitempty"`
+	Inputs     []EmbedInput   `json:"inputs"`
+}
+
+type EmbedInput struct {
+	Text string `json:"text"`
+}
+
+type EmbedResponse struct {
+	Model string `json:"model"`
+	// Data holds an embedding per input, in the order of the inputs.
+	Data  []Embedding `json:"data"`
+	Usage EmbedUsage  `json:"usage"`
+}
+
+type Embedding struct {
+	Values []float32 `json:"values"`
+}
+
+type EmbedUsage struct {
+	TotalTokens int `json:"total_tokens"`
+}
+
+// ModelInfo describes a model hosted by Pinecone.
+type ModelInfo struct {
+	Model            string `json:"model"`
+	ShortDescription string `json:"short_description"`
+	Type             string `json:"type"`                  // embed or rerank
+	VectorType       string `json:"vector_type,omitempty"` // dense or sparse, for embedding models
+	// SupportedDimensions is empty for sparse models, which have no dimension.
+	SupportedDimensions []int `json:"supported_dimensions,omitempty"`
+	DefaultDimension    int   `json:"default_dimension,omitempty"`
+	// SupportedMetrics are capitalized, e.g. Cosine and DotProduct.
+	SupportedMetrics  []string `json:"supported_metrics,omitempty"`
+	MaxSequenceLength int      `json:"max_sequence_length,omitempty"`
+}
+
+// controlPlaneIndex is an index as the control plane describes it.
+type controlPlaneIndex struct {
+	Name      string      `json:"name"`
+	Dimension int         `json:"dimension"`
+	Metric    Metric      `json:"metric"`
+	Host      string      `json:"host"`
+	Embed     *IndexEmbed `json:"embed,omitempty"`
+	Spec      struct {
+		Serverless *ServerlessSpec `json:"serverless,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		Ready bool   `json:"ready"`
+		State string `json:"state"`
+	} `json:"status"`
+}
+
+type ListModelsResponse struct {
+	Models []ModelInfo `json:"models"`
+}
+
+// lookupModel returns the model of a name in the models listed by ListModels.
+func lookupModel(models []ModelInfo, name string) (*ModelInfo, error) {
+	names := make([]string, len(models))
+	for i := range models {
+		if models[i].Model == name {
+			return &models[i], nil
+		}
+		names[i] = models[i].Model
+	}
+	return nil, fmt.Errorf("error: unknown embedding model %q, must be one of %s", name, strings.Join(names, ", "))
+}
+
+// CheckDimension returns an error if the model cannot produce embeddings of the dimension.
+func (m *ModelInfo) CheckDimension(dimension int) error {
+	if len(m.SupportedDimensions) == 0 {
+		return fmt.Errorf("error: embedding model %s is %s and has no dimension", m.Model, m.VectorType)
+	}
+	if slices.Contains(m.SupportedDimensions, dimension) {
+		return nil
+	}
+	dimensions := make([]string, len(m.SupportedDimensions))
+	for i, d := range m.SupportedDimensions {
+		dimensions[i] = fmt.Sprint(d)
+	}
+	return fmt.Errorf("error: embedding model %s has dimension %s, got %d", m.Model, strings.Join(dimensions, " or "), dimension)
+}
+
+// ListModels lists the embedding models hosted by Pinecone
+func (c *PineconeClient) ListModels(ctx context.Context) ([]ModelInfo, error) {
+	var resp ListModelsResponse
+	if _, err := c.do(ctx, "GET", "/models?type=embed", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Models, nil
+}
+
+// CreateIndexForModel creates a serverless index with integrated inference
+func (c *PineconeClient) CreateIndexForModel(ctx context.Context, req CreateIndexForModelRequest) error {
+	_, err := c.do(ctx, "POST", "/indexes/create-for-model", req, nil)
+	return err
+}
+
+// DescribeServerlessIndex describes an index through the control plane
+func (c *PineconeClient) DescribeServerlessIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error) {
+	var resp controlPlaneIndex
+	status, err := c.do(ctx, "GET", "/indexes/"+indexName, nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &DescribeIndexResponse{
+		Database: DescribeDatabaseResponse{
+			Name:       resp.Name,
+			Metric:     resp.Metric,
+			Dimension:  resp.Dimension,
+			Embed:      resp.Embed,
+			Serverless: resp.Spec.Serverless,
+		},
+		Status: DescribeStatusResponse{
+			Host:  resp.Host,
+			State: resp.Status.State,
+			Ready: resp.Status.Ready,
+		},
+	}, nil
+}
+
+// WaitForIndexForModel polls an index created by CreateIndexForModel until it is ready,
+// and returns an error if it disappeared.
+func WaitForIndexForModel(ctx context.Context, client PineconeClientInterface, indexName string) (*DescribeIndexResponse, error) {
+	for {
+		index, err := client.DescribeServerlessIndex(ctx, indexName)
+		if err != nil {
+			return nil, err
+		}
+		if index == nil {
+			return nil, fmt.Errorf("error: index %s not found", indexName)
+		}
+		if index.Status.Ready {
+			return index, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(indexPollInterval):
+		}
+	}
+}
+
+// Embed embeds texts with a hosted embedding model
+func (c *PineconeClient) Embed(ctx context.Context, req EmbedRequest) (*EmbedResponse, error) {
+	var resp EmbedResponse
+	if _, err := c.do(ctx, "POST", "/embed", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
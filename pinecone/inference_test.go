@@ -0,0 +1,239 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestEmbedClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "api.pinecone.io" || r.Header.Get("X-Pinecone-Api-Version") == "" {
+			t.Errorf("expected a versioned request to the control plane, got %s %s", r.Host, r.Header)
+			w.WriteHeader(http.StatusMisdirectedRequest)
+			return
+		}
+		if r.Method != "POST" || r.URL.Path != "/embed" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req EmbedRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Model != "llama-text-embed-v2" || req.Parameters["input_type"] != "passage" || len(req.Inputs) != 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":"INVALID_ARGUMENT"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"model":"llama-text-embed-v2","data":[{"values":[0.1,0.2]},{"values":[0.3,0.4]}],"usage":{"total_tokens":7}}`))
+	}))
+	defer server.Close()
+	target, _ := url.Parse(server.URL)
+
+	ctx := context.Background()
+	cli, err := NewClient("test_api_key", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli.HTTPClient = &http.Client{Transport: &rewriteTransport{target: target}}
+
+	resp, err := cli.Embed(ctx, EmbedRequest{
+		Model:      "llama-text-embed-v2",
+		Parameters: map[string]any{"input_type": "passage"},
+		Inputs:     []EmbedInput{{Text: "first"}, {Text: "second"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 2 || resp.Data[1].Values[1] != 0.4 || resp.Usage.TotalTokens != 7 {
+		t.Fatalf("unexpected embed response: %+v", resp)
+	}
+
+	if _, err := cli.Embed(ctx, EmbedRequest{Model: "llama-text-embed-v2"}); err == nil {
+		t.Fatal("expected an error for a bad request")
+	}
+}
+
+func TestListModelsClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "api.pinecone.io" || r.Header.Get("X-Pinecone-Api-Version") == "" {
+			t.Errorf("expected a versioned request to the control plane, got %s %s", r.Host, r.Header)
+			w.WriteHeader(http.StatusMisdirectedRequest)
+			return
+		}
+		if r.Method != "GET" || r.URL.Path != "/models" || r.URL.Query().Get("type") != "embed" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"models":[
+			{"model":"llama-text-embed-v2","short_description":"Dense","type":"embed","vector_type":"dense","default_dimension":1024,"supported_dimensions":[384,1024],"supported_metrics":["Cosine","DotProduct"],"max_sequence_length":2048},
+			{"model":"pinecone-sparse-english-v0","short_description":"Sparse","type":"embed","vector_type":"sparse","supported_metrics":["DotProduct"],"max_sequence_length":512}
+		]}`))
+	}))
+	defer server.Close()
+	target, _ := url.Parse(server.URL)
+
+	cli, err := NewClient("test_api_key", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli.HTTPClient = &http.Client{Transport: &rewriteTransport{target: target}}
+
+	models, err := cli.ListModels(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(models) != 2 || models[0].SupportedDimensions[1] != 1024 || models[1].VectorType != "sparse" || models[1].DefaultDimension != 0 {
+		t.Fatalf("unexpected models: %+v", models)
+	}
+	if defaultModelMetric(models[0]) != "cosine" || defaultModelMetric(models[1]) != "dotproduct" {
+		t.Fatalf("unexpected default metrics %q and %q", defaultModelMetric(models[0]), defaultModelMetric(models[1]))
+	}
+}
+
+func TestCreateIndexForModelClient(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "api.pinecone.io" || r.Header.Get("X-Pinecone-Api-Version") == "" {
+			t.Errorf("expected a versioned request to the control plane, got %s %s", r.Host, r.Header)
+			w.WriteHeader(http.StatusMisdirectedRequest)
+			return
+		}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/indexes/create-for-model":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"name":"docs"}`))
+		case r.Method == "GET" && r.URL.Path == "/indexes/docs":
+			_, _ = w.Write([]byte(`{"name":"docs","dimension":1024,"metric":"dotproduct","host":"docs-abc.svc.pinecone.io",` +
+				`"spec":{"serverless":{"cloud":"aws","region":"us-east-1"}},"status":{"ready":true,"state":"Ready"},` +
+				`"embed":{"model":"llama-text-embed-v2","field_map":{"text":"chunk_text"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	target, _ := url.Parse(server.URL)
+
+	cli, err := NewClient("test_api_key", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli.HTTPClient = &http.Client{Transport: &rewriteTransport{target: target}}
+
+	err = cli.CreateIndexForModel(context.Background(), CreateIndexForModelRequest{
+		Name:   "docs",
+		Cloud:  "aws",
+		Region: "us-east-1",
+		Embed: IndexForModelEmbed{
+			IndexEmbed: IndexEmbed{Model: "llama-text-embed-v2", FieldMap: map[string]string{"text": "chunk_text"}},
+			Metric:     MetricDotProduct,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	embed, _ := body["embed"].(map[string]any)
+	if body["name"] != "docs" || body["cloud"] != "aws" || body["region"] != "us-east-1" || embed == nil ||
+		embed["model"] != "llama-text-embed-v2" || embed["metric"] != "dotproduct" || embed["dimension"] != nil {
+		t.Fatalf("unexpected create for model body: %v", body)
+	}
+
+	index, err := WaitForIndexForModel(context.Background(), cli, "docs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.Database.Dimension != 1024 || index.Database.Metric != MetricDotProduct || index.Status.Host != "docs-abc.svc.pinecone.io" ||
+		index.Database.Serverless == nil || index.Database.Serverless.Region != "us-east-1" ||
+		index.Database.Embed == nil || index.Database.Embed.FieldMap["text"] != "chunk_text" {
+		t.Fatalf("unexpected described index: %+v", index)
+	}
+
+	missing, err := cli.DescribeServerlessIndex(context.Background(), "missing")
+	if err != nil || missing != nil {
+		t.Fatalf("expected a missing index to be nil, got %v, %v", missing, err)
+	}
+}
+
+// pendingIndexClient describes every index as not ready yet.
+type pendingIndexClient struct {
+	*MockPineconeClient
+}
+
+func (c *pendingIndexClient) DescribeServerlessIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error) {
+	index, err := c.MockPineconeClient.DescribeServerlessIndex(ctx, indexName)
+	if index == nil {
+		return index, err
+	}
+	pending := *index
+	pending.Status.Ready = false
+	return &pending, err
+}
+
+func TestWaitForIndexForModel(t *testing.T) {
+	interval := indexPollInterval
+	indexPollInterval = time.Millisecond
+	defer func() { indexPollInterval = interval }()
+
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = mock.CreateIndexForModel(context.Background(), CreateIndexForModelRequest{
+		Name: "docs", Cloud: "aws", Region: "us-east-1",
+		Embed: IndexForModelEmbed{IndexEmbed: IndexEmbed{Model: "llama-text-embed-v2", FieldMap: map[string]string{"text": "chunk_text"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = WaitForIndexForModel(context.Background(), mock, "missing")
+	if err == nil || err.Error() != "error: index missing not found" {
+		t.Fatalf("expected a missing index to fail, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = WaitForIndexForModel(ctx, &pendingIndexClient{MockPineconeClient: mock}, "docs")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected waiting to stop with the context, got %v", err)
+	}
+}
+
+func TestMockEmbed(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := cli.Embed(ctx, EmbedRequest{
+		Model:      "llama-text-embed-v2",
+		Parameters: map[string]any{"dimension": 384},
+		Inputs:     []EmbedInput{{Text: "a quiet film"}, {Text: "a quiet film"}, {Text: "a loud film"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 3 || len(resp.Data[0].Values) != 384 || resp.Usage.TotalTokens != 9 {
+		t.Fatalf("unexpected embed response: %d embeddings, %d tokens", len(resp.Data), resp.Usage.TotalTokens)
+	}
+	if same := mockScore(MetricDotProduct, resp.Data[0].Values, resp.Data[1].Values); math.Abs(float64(same)-1) > 1e-5 {
+		t.Fatalf("expected equal texts to have equal unit embeddings, got score %v", same)
+	}
+	if different := mockScore(MetricCosine, resp.Data[0].Values, resp.Data[2].Values); different > 0.99 {
+		t.Fatalf("expected different texts to have different embeddings, got score %v", different)
+	}
+
+	if _, err := cli.Embed(ctx, EmbedRequest{Model: "llama-text-embed-v2", Parameters: map[string]any{"dimension": 1536}}); err == nil {
+		t.Fatal("expected an error for an unsupported dimension")
+	}
+	if _, err := cli.Embed(ctx, EmbedRequest{Model: "text-embedding-ada-002"}); err == nil {
+		t.Fatal("expected an error for an unknown model")
+	}
+}
@@ -0,0 +1,130 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &metadataFilterFunction{}
+)
+
+// NewMetadataFilterFunction is a helper function to simplify the provider implementation.
+func NewMetadataFilterFunction() function.Function {
+	return &metadataFilterFunction{}
+}
+
+// metadataFilterFunction is the function implementation.
+type metadataFilterFunction struct{}
+
+// Metadata returns the function name.
+func (f *metadataFilterFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "metadata_filter"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *metadataFilterFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a metadata filter.",
+		Description: "Check a metadata filter such as { genre = { \"$in\" = [\"comedy\", \"drama\"] } } and return it as JSON for the filter " +
+			"argument of data sources, failing with the path of the first invalid operator or value. " +
+			"The filter is an object or a JSON string; shorthand field values are expanded to $eq.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "filter",
+				Description: "The filter to validate, as an object or a JSON string.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run validates the filter.
+func (f *metadataFilterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var filterValue types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &filterValue))
+	if resp.Error != nil {
+		return
+	}
+
+	var filter *MetadataFilter
+	var err error
+	if s, ok := filterValue.UnderlyingValue().(types.String); ok {
+		filter, err = ParseMetadataFilterJSON(s.ValueString())
+	} else {
+		var doc any
+		doc, err = filterDocument(filterValue)
+		if err == nil {
+			filter, err = ParseMetadataFilter(doc)
+		}
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, filter.JSON()))
+}
+
+// filterDocument converts a Terraform value to the form of a decoded JSON document.
+func filterDocument(value attr.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("error: filter must be known")
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return filterDocument(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		number, _ := v.ValueBigFloat().Float64()
+		return number, nil
+	case basetypes.ObjectValue:
+		return filterObjectDocument(v.Attributes())
+	case basetypes.MapValue:
+		return filterObjectDocument(v.Elements())
+	case basetypes.TupleValue:
+		elements = v.Elements()
+	case basetypes.ListValue:
+		elements = v.Elements()
+	case basetypes.SetValue:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("error: unsupported filter value %s", value)
+	}
+
+	list := make([]any, len(elements))
+	for i, element := range elements {
+		converted, err := filterDocument(element)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = converted
+	}
+	return list, nil
+}
+
+func filterObjectDocument(attributes map[string]attr.Value) (any, error) {
+	object := make(map[string]any, len(attributes))
+	for key, attribute := range attributes {
+		converted, err := filterDocument(attribute)
+		if err != nil {
+			return nil, err
+		}
+		object[key] = converted
+	}
+	return object, nil
+}
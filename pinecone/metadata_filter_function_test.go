@@ -0,0 +1,105 @@
+package pinecone
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestMetadataFilterFunction(t *testing.T) {
+	object := types.ObjectValueMust(
+		map[string]attr.Type{
+			"genre": types.StringType,
+			"year":  types.ObjectType{AttrTypes: map[string]attr.Type{"$gte": types.NumberType}},
+			"tags":  types.ObjectType{AttrTypes: map[string]attr.Type{"$in": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}}}},
+		},
+		map[string]attr.Value{
+			"genre": types.StringValue("drama"),
+			"year": types.ObjectValueMust(map[string]attr.Type{"$gte": types.NumberType}, map[string]attr.Value{
+				"$gte": types.NumberValue(big.NewFloat(2020)),
+			}),
+			"tags": types.ObjectValueMust(
+				map[string]attr.Type{"$in": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}}},
+				map[string]attr.Value{
+					"$in": types.TupleValueMust([]attr.Type{types.StringType, types.NumberType}, []attr.Value{
+						types.StringValue("a"), types.NumberValue(big.NewFloat(1.5)),
+					}),
+				},
+			),
+		},
+	)
+
+	testCases := []struct {
+		filter   attr.Value
+		expected string
+		err      string
+	}{
+		{
+			filter:   object,
+			expected: `{"genre":{"$eq":"drama"},"tags":{"$in":["a",1.5]},"year":{"$gte":2020}}`,
+		},
+		{
+			filter:   types.StringValue(`{"$and":[{"genre":"drama"}]}`),
+			expected: `{"$and":[{"genre":{"$eq":"drama"}}]}`,
+		},
+		{
+			filter: types.StringValue(`{"genre":{"$like":"d%"}}`),
+			err:    "filter.genre.$like: unknown operator $like",
+		},
+		{
+			filter: types.BoolValue(true),
+			err:    "filter: must be an object, got a boolean",
+		},
+	}
+
+	for _, tC := range testCases {
+		result, err := runFunction(NewMetadataFilterFunction(), types.StringUnknown(), types.DynamicValue(tC.filter))
+		if tC.err != "" {
+			if err == nil || !strings.Contains(err.Text, tC.err) {
+				t.Errorf("metadata_filter(%s) = (%v, %v), expected error %q", tC.filter, result, err, tC.err)
+			}
+			continue
+		}
+		if err != nil || !result.Equal(types.StringValue(tC.expected)) {
+			t.Errorf("metadata_filter(%s) = (%v, %v), expected %s", tC.filter, result, err, tC.expected)
+		}
+	}
+}
+
+func TestAccMetadataFilterFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "filter" {
+    value = provider::pinecone::metadata_filter({
+        genre = { "$in" = ["comedy", "drama"] }
+        "$or" = [{ year = { "$gte" = 2020 } }, { featured = true }]
+    })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("filter", `{"$or":[{"year":{"$gte":2020}},{"featured":{"$eq":true}}],"genre":{"$in":["comedy","drama"]}}`),
+				),
+			},
+			{
+				Config: `
+output "filter" {
+    value = provider::pinecone::metadata_filter({ "$and" = [{ year = { "$between" = [2020, 2024] } }] })
+}
+`,
+				ExpectError: regexp.MustCompile(`filter\.\$and\[0\]\.year\.\$between:\s+unknown\s+operator`),
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	_ AdminClientInterface = &MockAdminClient{}
+)
+
+// MockAdminClient is an in-memory admin API for tests.
+type MockAdminClient struct {
+	OrganizationID string
+	projects       map[string]*Project
+	apiKeys        map[string]*APIKey
+	nextID         int
+	mutex          sync.Mutex
+}
+
+func NewMockAdminClient() *MockAdminClient {
+	return &MockAdminClient{
+		OrganizationID: "mock-org",
+		projects:       make(map[string]*Project),
+		apiKeys:        make(map[string]*APIKey),
+	}
+}
+
+func (c *MockAdminClient) ListProjects(ctx context.Context) ([]Project, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	projects := make([]Project, 0, len(c.projects))
+	for _, project := range c.projects {
+		projects = append(projects, *project)
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	return projects, nil
+}
+
+func (c *MockAdminClient) CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, project := range c.projects {
+		if project.Name == req.Name {
+			return nil, fmt.Errorf("error: project %s already exists", req.Name)
+		}
+	}
+
+	c.nextID++
+	project := &Project{
+		ID:                      fmt.Sprintf("mock-project-%d", c.nextID),
+		Name:                    req.Name,
+		ForceEncryptionWithCMEK: req.ForceEncryptionWithCMEK,
+		OrganizationID:          c.OrganizationID,
+		CreatedAt:               time.Now().UTC().Format(time.RFC3339),
+	}
+	if req.MaxPods != nil {
+		project.MaxPods = *req.MaxPods
+	}
+	c.projects[project.ID] = project
+
+	result := *project
+	return &result, nil
+}
+
+func (c *MockAdminClient) DescribeProject(ctx context.Context, projectID string) (*Project, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	project, exists := c.projects[projectID]
+	if !exists {
+		return nil, nil
+	}
+	result := *project
+	return &result, nil
+}
+
+func (c *MockAdminClient) UpdateProject(ctx context.Context, projectID string, req UpdateProjectRequest) (*Project, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	project, exists := c.projects[projectID]
+	if !exists {
+		return nil, fmt.Errorf("error: project %s not found", projectID)
+	}
+	if req.ForceEncryptionWithCMEK != nil && project.ForceEncryptionWithCMEK && !*req.ForceEncryptionWithCMEK {
+		return nil, fmt.Errorf("error: project %s cannot disable CMEK encryption", projectID)
+	}
+
+	if req.Name != nil {
+		project.Name = *req.Name
+	}
+	if req.MaxPods != nil {
+		project.MaxPods = *req.MaxPods
+	}
+	if req.ForceEncryptionWithCMEK != nil {
+		project.ForceEncryptionWithCMEK = *req.ForceEncryptionWithCMEK
+	}
+
+	result := *project
+	return &result, nil
+}
+
+func (c *MockAdminClient) DeleteProject(ctx context.Context, projectID string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.projects[projectID]; !exists {
+		return fmt.Errorf("error: project %s not found", projectID)
+	}
+	delete(c.projects, projectID)
+	return nil
+}
+
+func (c *MockAdminClient) ListAPIKeys(ctx context.Context, projectID string) ([]APIKey, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.projects[projectID]; !exists {
+		return nil, fmt.Errorf("error: project %s not found", projectID)
+	}
+	apiKeys := []APIKey{}
+	for _, apiKey := range c.apiKeys {
+		if apiKey.ProjectID == projectID {
+			apiKeys = append(apiKeys, copyAPIKey(apiKey))
+		}
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].ID < apiKeys[j].ID })
+	return apiKeys, nil
+}
+
+func (c *MockAdminClient) CreateAPIKey(ctx context.Context, projectID string, req CreateAPIKeyRequest) (*APIKeyWithSecret, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.projects[projectID]; !exists {
+		return nil, fmt.Errorf("error: project %s not found", projectID)
+	}
+	roles := req.Roles
+	if len(roles) == 0 {
+		roles = []string{"ProjectEditor"}
+	}
+
+	c.nextID++
+	apiKey := &APIKey{
+		ID:        fmt.Sprintf("mock-api-key-%d", c.nextID),
+		Name:      req.Name,
+		ProjectID: projectID,
+		Roles:     append([]string(nil), roles...),
+	}
+	c.apiKeys[apiKey.ID] = apiKey
+
+	return &APIKeyWithSecret{
+		Key:   copyAPIKey(apiKey),
+		Value: fmt.Sprintf("pcsk_mock_%d", c.nextID),
+	}, nil
+}
+
+func (c *MockAdminClient) DescribeAPIKey(ctx context.Context, apiKeyID string) (*APIKey, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	apiKey, exists := c.apiKeys[apiKeyID]
+	if !exists {
+		return nil, nil
+	}
+	result := copyAPIKey(apiKey)
+	return &result, nil
+}
+
+func (c *MockAdminClient) UpdateAPIKey(ctx context.Context, apiKeyID string, req UpdateAPIKeyRequest) (*APIKey, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	apiKey, exists := c.apiKeys[apiKeyID]
+	if !exists {
+		return nil, fmt.Errorf("error: API key %s not found", apiKeyID)
+	}
+	if req.Name != nil {
+		apiKey.Name = *req.Name
+	}
+	if req.Roles != nil {
+		apiKey.Roles = append([]string(nil), req.Roles...)
+	}
+
+	result := copyAPIKey(apiKey)
+	return &result, nil
+}
+
+func (c *MockAdminClient) DeleteAPIKey(ctx context.Context, apiKeyID string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.apiKeys[apiKeyID]; !exists {
+		return fmt.Errorf("error: API key %s not found", apiKeyID)
+	}
+	delete(c.apiKeys, apiKeyID)
+	return nil
+}
+
+// copyAPIKey copies an API key so callers cannot change the stored roles.
+func copyAPIKey(apiKey *APIKey) APIKey {
+	result := *apiKey
+	result.Roles = append([]string(nil), apiKey.Roles...)
+	return result
+}
@@ -3,8 +3,15 @@ package pinecone
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -15,7 +22,29 @@ type MockPineconeClient struct {
 	APIKey      string
 	Environment string
 	indexes     map[string]*DescribeIndexResponse
-	mutex       sync.Mutex
+	// vectors holds the data plane: index name -> namespace -> vector ID -> vector.
+	vectors map[string]map[string]map[string]Vector
+	backups map[string]*mockBackup
+	// restoreJobs are kept in creation order.
+	restoreJobs []RestoreJob
+	assistants  map[string]*Assistant
+	// assistantFiles holds the files of each assistant by ID.
+	assistantFiles map[string]map[string]*mockAssistantFile
+	nextID         int
+	mutex          sync.Mutex
+}
+
+// mockBackup is a backup with a copy of the index it was taken of.
+type mockBackup struct {
+	Backup
+	database DescribeDatabaseResponse
+	vectors  map[string]map[string]Vector
+}
+
+// mockAssistantFile is a file of an assistant with its content.
+type mockAssistantFile struct {
+	AssistantFile
+	content []byte
 }
 
 func NewMockClient(options ...Option) (*MockPineconeClient, error) {
@@ -29,9 +58,13 @@ func NewMockClient(options ...Option) (*MockPineconeClient, error) {
 	}
 
 	return &MockPineconeClient{
-		APIKey:      opts.APIKey,
-		Environment: opts.Environment,
-		indexes:     make(map[string]*DescribeIndexResponse),
+		APIKey:         opts.APIKey,
+		Environment:    opts.Environment,
+		indexes:        make(map[string]*DescribeIndexResponse),
+		vectors:        make(map[string]map[string]map[string]Vector),
+		backups:        make(map[string]*mockBackup),
+		assistants:     make(map[string]*Assistant),
+		assistantFiles: make(map[string]map[string]*mockAssistantFile),
 	}, nil
 }
 
@@ -79,9 +112,59 @@ func (c *MockPineconeClient) CreateIndex(ctx context.Context, req CreateIndexReq
 			MetadataConfig: req.MetadataConfig,
 		},
 		Status: DescribeStatusResponse{
+			Host:  fmt.Sprintf("%s-mock.svc.%s.pinecone.io", req.Name, c.Environment),
+			Port:  443,
+			State: "Ready",
 			Ready: true,
 		},
 	}
+	c.vectors[req.Name] = make(map[string]map[string]Vector)
+	return nil
+}
+
+// CreateIndexForModel saves a serverless index, without pods, of the dimension and metric of the model.
+func (c *MockPineconeClient) CreateIndexForModel(ctx context.Context, req CreateIndexForModelRequest) error {
+	model, err := LookupEmbeddingModel(req.Embed.Model)
+	if err != nil {
+		return err
+	}
+	dimension, metric := req.Embed.Dimension, req.Embed.Metric
+	if dimension == 0 {
+		dimension = model.DefaultDimension
+	}
+	if metric == "" {
+		metric = model.DefaultMetric
+	}
+	if err := model.CheckDimension(dimension); err != nil {
+		return err
+	}
+	if err := model.CheckMetric(metric); err != nil {
+		return err
+	}
+	if req.Cloud == "" || req.Region == "" {
+		return fmt.Errorf("error: cloud and region are required")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	embed := req.Embed.IndexEmbed
+	c.indexes[req.Name] = &DescribeIndexResponse{
+		Database: DescribeDatabaseResponse{
+			Name:       req.Name,
+			Metric:     metric,
+			Dimension:  dimension,
+			Embed:      &embed,
+			Serverless: &ServerlessSpec{Cloud: req.Cloud, Region: req.Region},
+		},
+		Status: DescribeStatusResponse{
+			Host:  fmt.Sprintf("%s-mock.svc.pinecone.io", req.Name),
+			Port:  443,
+			State: "Ready",
+			Ready: true,
+		},
+	}
+	c.vectors[req.Name] = make(map[string]map[string]Vector)
 	return nil
 }
 
@@ -97,11 +180,17 @@ func (c *MockPineconeClient) DescribeIndex(ctx context.Context, indexName string
 	return index, nil
 }
 
+// DescribeServerlessIndex describes an index like DescribeIndex, as the mock has a single control plane.
+func (c *MockPineconeClient) DescribeServerlessIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error) {
+	return c.DescribeIndex(ctx, indexName)
+}
+
 func (c *MockPineconeClient) DeleteIndex(ctx context.Context, indexName string) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
 	delete(c.indexes, indexName)
+	delete(c.vectors, indexName)
 	return nil
 }
 
@@ -114,11 +203,786 @@ func (c *MockPineconeClient) ConfigureIndex(ctx context.Context, indexName strin
 		return fmt.Errorf("index not found: %s", indexName)
 	}
 
-	index.Database.Replicas = req.Replicas
-	index.Database.PodType = req.PodType
+	if req.Embed != nil {
+		if index.Database.Embed == nil {
+			return fmt.Errorf("error: index %s has no integrated embedding model", indexName)
+		}
+		if req.Embed.Model != index.Database.Embed.Model {
+			return fmt.Errorf("error: cannot change the embedding model of index %s", indexName)
+		}
+		embed := *index.Database.Embed
+		embed.ReadParameters = req.Embed.ReadParameters
+		embed.WriteParameters = req.Embed.WriteParameters
+		index.Database.Embed = &embed
+	}
+
+	if index.Database.Serverless != nil && (req.Replicas != nil || req.PodType != nil) {
+		return fmt.Errorf("error: serverless index %s has no replicas or pod type to configure", indexName)
+	}
+	if req.Replicas != nil {
+		index.Database.Replicas = *req.Replicas
+	}
+	if req.PodType != nil {
+		index.Database.PodType = *req.PodType
+	}
 
 	// save the index
 	c.indexes[indexName] = index
 
 	return nil
 }
+
+func (c *MockPineconeClient) ListBackups(ctx context.Context) ([]Backup, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	backups := make([]Backup, 0, len(c.backups))
+	for _, backup := range c.backups {
+		backups = append(backups, backup.Backup)
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].ID < backups[j].ID })
+	return backups, nil
+}
+
+func (c *MockPineconeClient) CreateBackup(ctx context.Context, indexName string, req CreateBackupRequest) (*Backup, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	index, exists := c.indexes[indexName]
+	if !exists {
+		return nil, fmt.Errorf("error: index %s not found", indexName)
+	}
+
+	// Vectors are replaced rather than changed by Upsert, so copying the maps is enough.
+	vectors := make(map[string]map[string]Vector, len(c.vectors[indexName]))
+	recordCount := 0
+	for namespace, namespaceVectors := range c.vectors[indexName] {
+		vectors[namespace] = make(map[string]Vector, len(namespaceVectors))
+		for id, vector := range namespaceVectors {
+			vectors[namespace][id] = vector
+		}
+		recordCount += len(namespaceVectors)
+	}
+
+	c.nextID++
+	backup := &mockBackup{
+		Backup: Backup{
+			ID:              fmt.Sprintf("mock-backup-%d", c.nextID),
+			SourceIndexName: indexName,
+			SourceIndexID:   indexName + "-mock",
+			Name:            req.Name,
+			Description:     req.Description,
+			Status:          BackupStatusReady,
+			Dimension:       index.Database.Dimension,
+			Metric:          index.Database.Metric,
+			RecordCount:     recordCount,
+			NamespaceCount:  len(vectors),
+			SizeBytes:       int64(recordCount * index.Database.Dimension * 4),
+			CreatedAt:       time.Now().UTC().Format(time.RFC3339),
+		},
+		database: index.Database,
+		vectors:  vectors,
+	}
+	c.backups[backup.ID] = backup
+
+	result := backup.Backup
+	return &result, nil
+}
+
+func (c *MockPineconeClient) DescribeBackup(ctx context.Context, backupID string) (*Backup, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	backup, exists := c.backups[backupID]
+	if !exists {
+		return nil, nil
+	}
+	result := backup.Backup
+	return &result, nil
+}
+
+func (c *MockPineconeClient) DeleteBackup(ctx context.Context, backupID string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.backups[backupID]; !exists {
+		return fmt.Errorf("error: backup %s not found", backupID)
+	}
+	delete(c.backups, backupID)
+	return nil
+}
+
+func (c *MockPineconeClient) CreateIndexFromBackup(ctx context.Context, backupID string, req CreateIndexFromBackupRequest) (*CreateIndexFromBackupResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	backup, exists := c.backups[backupID]
+	if !exists {
+		return nil, fmt.Errorf("error: backup %s not found", backupID)
+	}
+	if _, exists := c.indexes[req.Name]; exists {
+		return nil, fmt.Errorf("error: index %s already exists", req.Name)
+	}
+
+	database := backup.database
+	database.Name = req.Name
+	c.indexes[req.Name] = &DescribeIndexResponse{
+		Database: database,
+		Status: DescribeStatusResponse{
+			Host:  fmt.Sprintf("%s-mock.svc.%s.pinecone.io", req.Name, c.Environment),
+			Port:  443,
+			State: "Ready",
+			Ready: true,
+		},
+	}
+	vectors := make(map[string]map[string]Vector, len(backup.vectors))
+	for namespace, namespaceVectors := range backup.vectors {
+		vectors[namespace] = make(map[string]Vector, len(namespaceVectors))
+		for id, vector := range namespaceVectors {
+			vectors[namespace][id] = vector
+		}
+	}
+	c.vectors[req.Name] = vectors
+
+	c.nextID++
+	now := time.Now().UTC().Format(time.RFC3339)
+	job := RestoreJob{
+		ID:              fmt.Sprintf("mock-restore-job-%d", c.nextID),
+		BackupID:        backupID,
+		TargetIndexName: req.Name,
+		TargetIndexID:   req.Name + "-mock",
+		Status:          RestoreJobStatusCompleted,
+		PercentComplete: 100,
+		CreatedAt:       now,
+		CompletedAt:     now,
+	}
+	c.restoreJobs = append(c.restoreJobs, job)
+
+	return &CreateIndexFromBackupResponse{
+		RestoreJobID: job.ID,
+		IndexID:      job.TargetIndexID,
+	}, nil
+}
+
+func (c *MockPineconeClient) ListRestoreJobs(ctx context.Context) ([]RestoreJob, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]RestoreJob{}, c.restoreJobs...), nil
+}
+
+func (c *MockPineconeClient) DescribeRestoreJob(ctx context.Context, jobID string) (*RestoreJob, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, job := range c.restoreJobs {
+		if job.ID == jobID {
+			return &job, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListModels lists the models of the catalog, and a sparse model the catalog leaves out.
+func (c *MockPineconeClient) ListModels(ctx context.Context) ([]ModelInfo, error) {
+	models := make([]ModelInfo, 0, len(EmbeddingModels)+1)
+	for _, model := range EmbeddingModels {
+		metrics := make([]string, len(model.Metrics))
+		for i, metric := range model.Metrics {
+			metrics[i] = map[Metric]string{MetricCosine: "Cosine", MetricEuclidean: "Euclidean", MetricDotProduct: "DotProduct"}[metric]
+		}
+		models = append(models, ModelInfo{
+			Model:               model.Name,
+			ShortDescription:    model.Description,
+			Type:                "embed",
+			VectorType:          "dense",
+			SupportedDimensions: model.Dimensions,
+			DefaultDimension:    model.DefaultDimension,
+			SupportedMetrics:    metrics,
+			MaxSequenceLength:   model.MaxSequenceLength,
+		})
+	}
+	models = append(models, ModelInfo{
+		Model:             "pinecone-sparse-english-v0",
+		ShortDescription:  "Sparse model for keyword search in English.",
+		Type:              "embed",
+		VectorType:        "sparse",
+		SupportedMetrics:  []string{"DotProduct"},
+		MaxSequenceLength: 512,
+	})
+	return models, nil
+}
+
+// Embed returns a unit vector per input that only depends on the text, so equal texts have a score of 1.
+func (c *MockPineconeClient) Embed(ctx context.Context, req EmbedRequest) (*EmbedResponse, error) {
+	if len(req.Inputs) == 0 {
+		return nil, fmt.Errorf("error: POST /embed status code: 400: inputs must not be empty")
+	}
+	model, err := LookupEmbeddingModel(req.Model)
+	if err != nil {
+		return nil, err
+	}
+	dimension := model.DefaultDimension
+	switch value := req.Parameters["dimension"].(type) {
+	case int:
+		dimension = value
+	case int64:
+		dimension = int(value)
+	case float64:
+		dimension = int(value)
+	}
+	if err := model.CheckDimension(dimension); err != nil {
+		return nil, err
+	}
+
+	resp := &EmbedResponse{
+		Model: model.Name,
+		Data:  make([]Embedding, len(req.Inputs)),
+	}
+	for i, input := range req.Inputs {
+		hash := fnv.New64a()
+		hash.Write([]byte(input.Text))
+		seed := float64(hash.Sum64()%1000) + 1
+
+		values := make([]float32, dimension)
+		var norm float64
+		for j := range values {
+			value := math.Sin(seed * float64(j+1))
+			values[j] = float32(value)
+			norm += value * value
+		}
+		for j := range values {
+			values[j] = float32(float64(values[j]) / math.Sqrt(norm))
+		}
+		resp.Data[i] = Embedding{Values: values}
+		resp.Usage.TotalTokens += len(strings.Fields(input.Text))
+	}
+	return resp, nil
+}
+
+func (c *MockPineconeClient) CreateAssistant(ctx context.Context, req CreateAssistantRequest) (*Assistant, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.assistants[req.Name]; exists {
+		return nil, fmt.Errorf("error: assistant %s already exists", req.Name)
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	assistant := &Assistant{
+		Name:         req.Name,
+		Instructions: req.Instructions,
+		Metadata:     req.Metadata,
+		Status:       AssistantStatusReady,
+		Host:         fmt.Sprintf("%s-mock.svc.%s.pinecone.io", req.Name, c.Environment),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	c.assistants[req.Name] = assistant
+	c.assistantFiles[req.Name] = make(map[string]*mockAssistantFile)
+
+	result := *assistant
+	return &result, nil
+}
+
+func (c *MockPineconeClient) DescribeAssistant(ctx context.Context, name string) (*Assistant, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	assistant, exists := c.assistants[name]
+	if !exists {
+		return nil, nil
+	}
+	result := *assistant
+	return &result, nil
+}
+
+func (c *MockPineconeClient) UpdateAssistant(ctx context.Context, name string, req UpdateAssistantRequest) (*Assistant, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	assistant, exists := c.assistants[name]
+	if !exists {
+		return nil, fmt.Errorf("error: assistant %s not found", name)
+	}
+	assistant.Instructions = req.Instructions
+	assistant.Metadata = req.Metadata
+	assistant.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	result := *assistant
+	return &result, nil
+}
+
+func (c *MockPineconeClient) DeleteAssistant(ctx context.Context, name string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.assistants[name]; !exists {
+		return fmt.Errorf("error: assistant %s not found", name)
+	}
+	delete(c.assistants, name)
+	delete(c.assistantFiles, name)
+	return nil
+}
+
+// UploadAssistantFile stores the file, which is available right away.
+func (c *MockPineconeClient) UploadAssistantFile(ctx context.Context, assistantName string, fileName string, content io.Reader, metadata map[string]any) (*AssistantFile, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	files, exists := c.assistantFiles[assistantName]
+	if !exists {
+		return nil, fmt.Errorf("error: assistant %s not found", assistantName)
+	}
+	c.nextID++
+	now := time.Now().UTC().Format(time.RFC3339)
+	file := &mockAssistantFile{
+		AssistantFile: AssistantFile{
+			ID:          fmt.Sprintf("mock-file-%d", c.nextID),
+			Name:        fileName,
+			Metadata:    metadata,
+			Status:      AssistantFileStatusAvailable,
+			PercentDone: 1,
+			CreatedOn:   now,
+			UpdatedOn:   now,
+		},
+		content: data,
+	}
+	files[file.ID] = file
+
+	result := file.AssistantFile
+	return &result, nil
+}
+
+func (c *MockPineconeClient) DescribeAssistantFile(ctx context.Context, assistantName string, fileID string) (*AssistantFile, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	files, exists := c.assistantFiles[assistantName]
+	if !exists {
+		return nil, fmt.Errorf("error: assistant %s not found", assistantName)
+	}
+	file, exists := files[fileID]
+	if !exists {
+		return nil, nil
+	}
+	result := file.AssistantFile
+	return &result, nil
+}
+
+func (c *MockPineconeClient) DeleteAssistantFile(ctx context.Context, assistantName string, fileID string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	files, exists := c.assistantFiles[assistantName]
+	if !exists {
+		return fmt.Errorf("error: assistant %s not found", assistantName)
+	}
+	if _, exists := files[fileID]; !exists {
+		return fmt.Errorf("error: file %s of assistant %s not found", fileID, assistantName)
+	}
+	delete(files, fileID)
+	return nil
+}
+
+// AssistantFileContents returns the content of each file of an assistant by file name.
+func (c *MockPineconeClient) AssistantFileContents(assistantName string) map[string]string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	contents := make(map[string]string)
+	for _, file := range c.assistantFiles[assistantName] {
+		contents[file.Name] = string(file.content)
+	}
+	return contents
+}
+
+// namespaces returns the vectors of an index, or an error like an unreachable host would.
+func (c *MockPineconeClient) namespaces(indexName string) (map[string]map[string]Vector, error) {
+	namespaces, exists := c.vectors[indexName]
+	if !exists {
+		return nil, fmt.Errorf("error: index %s not found", indexName)
+	}
+	return namespaces, nil
+}
+
+func (c *MockPineconeClient) DescribeIndexStats(ctx context.Context, indexName string, req DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	namespaces, err := c.namespaces(indexName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DescribeIndexStatsResponse{
+		Namespaces: make(map[string]NamespaceSummary),
+		Dimension:  c.indexes[indexName].Database.Dimension,
+	}
+	for namespace, vectors := range namespaces {
+		count := 0
+		for _, vector := range vectors {
+			if mockMatchesFilter(vector.Metadata, req.Filter) {
+				count++
+			}
+		}
+		if count > 0 || len(req.Filter) == 0 {
+			resp.Namespaces[namespace] = NamespaceSummary{VectorCount: count}
+		}
+		resp.TotalVectorCount += count
+	}
+	return resp, nil
+}
+
+func (c *MockPineconeClient) Upsert(ctx context.Context, indexName string, req UpsertRequest) (*UpsertResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	namespaces, err := c.namespaces(indexName)
+	if err != nil {
+		return nil, err
+	}
+
+	dimension := c.indexes[indexName].Database.Dimension
+	for _, vector := range req.Vectors {
+		if len(vector.Values) != dimension {
+			return nil, fmt.Errorf("error: vector %s has dimension %d, index %s has dimension %d", vector.ID, len(vector.Values), indexName, dimension)
+		}
+		if vector.SparseValues != nil {
+			if err := checkSparseMetric(indexName, c.indexes[indexName].Database.Metric); err != nil {
+				return nil, err
+			}
+			if err := vector.SparseValues.Validate(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if namespaces[req.Namespace] == nil {
+		namespaces[req.Namespace] = make(map[string]Vector)
+	}
+	for _, vector := range req.Vectors {
+		namespaces[req.Namespace][vector.ID] = vector
+	}
+	return &UpsertResponse{UpsertedCount: len(req.Vectors)}, nil
+}
+
+func (c *MockPineconeClient) Fetch(ctx context.Context, indexName string, req FetchRequest) (*FetchResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	namespaces, err := c.namespaces(indexName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &FetchResponse{
+		Vectors:   make(map[string]Vector),
+		Namespace: req.Namespace,
+	}
+	for _, id := range req.IDs {
+		if vector, ok := namespaces[req.Namespace][id]; ok {
+			resp.Vectors[id] = vector
+		}
+	}
+	return resp, nil
+}
+
+func (c *MockPineconeClient) Query(ctx context.Context, indexName string, req QueryRequest) (*QueryResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	namespaces, err := c.namespaces(indexName)
+	if err != nil {
+		return nil, err
+	}
+
+	metric := c.indexes[indexName].Database.Metric
+	if req.SparseVector != nil {
+		if err := checkSparseMetric(indexName, metric); err != nil {
+			return nil, err
+		}
+		if err := req.SparseVector.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	values, sparseValues := req.Vector, req.SparseVector
+	if req.ID != "" {
+		vector, ok := namespaces[req.Namespace][req.ID]
+		if !ok {
+			return &QueryResponse{Matches: []ScoredVector{}, Namespace: req.Namespace}, nil
+		}
+		values, sparseValues = vector.Values, vector.SparseValues
+	}
+
+	matches := []ScoredVector{}
+	for _, vector := range namespaces[req.Namespace] {
+		if !mockMatchesFilter(vector.Metadata, req.Filter) {
+			continue
+		}
+		match := ScoredVector{
+			ID:    vector.ID,
+			Score: mockScore(metric, values, vector.Values) + mockSparseScore(sparseValues, vector.SparseValues),
+		}
+		if req.IncludeValues {
+			match.Values = vector.Values
+			match.SparseValues = vector.SparseValues
+		}
+		if req.IncludeMetadata {
+			match.Metadata = vector.Metadata
+		}
+		matches = append(matches, match)
+	}
+
+	// Euclidean scores are distances, so lower is closer.
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score == matches[j].Score {
+			return matches[i].ID < matches[j].ID
+		}
+		if metric == MetricEuclidean {
+			return matches[i].Score < matches[j].Score
+		}
+		return matches[i].Score > matches[j].Score
+	})
+	if req.TopK < len(matches) {
+		matches = matches[:req.TopK]
+	}
+	return &QueryResponse{Matches: matches, Namespace: req.Namespace}, nil
+}
+
+func (c *MockPineconeClient) Delete(ctx context.Context, indexName string, req DeleteRequest) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	namespaces, err := c.namespaces(indexName)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case req.DeleteAll:
+		delete(namespaces, req.Namespace)
+	case len(req.Filter) > 0:
+		for id, vector := range namespaces[req.Namespace] {
+			if mockMatchesFilter(vector.Metadata, req.Filter) {
+				delete(namespaces[req.Namespace], id)
+			}
+		}
+	default:
+		for _, id := range req.IDs {
+			delete(namespaces[req.Namespace], id)
+		}
+	}
+	if len(namespaces[req.Namespace]) == 0 {
+		delete(namespaces, req.Namespace)
+	}
+	return nil
+}
+
+func (c *MockPineconeClient) List(ctx context.Context, indexName string, req ListRequest) (*ListResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	namespaces, err := c.namespaces(indexName)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(namespaces[req.Namespace]))
+	for id := range namespaces[req.Namespace] {
+		if strings.HasPrefix(id, req.Prefix) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	// The pagination token is the offset of the next page.
+	start := 0
+	if req.PaginationToken != "" {
+		start, err = strconv.Atoi(req.PaginationToken)
+		if err != nil || start < 0 || start > len(ids) {
+			return nil, fmt.Errorf("error: invalid pagination token: %s", req.PaginationToken)
+		}
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	end := start + limit
+	if end > len(ids) {
+		end = len(ids)
+	}
+
+	resp := &ListResponse{
+		Vectors:   make([]ListItem, 0, end-start),
+		Namespace: req.Namespace,
+	}
+	for _, id := range ids[start:end] {
+		resp.Vectors = append(resp.Vectors, ListItem{ID: id})
+	}
+	if end < len(ids) {
+		resp.Pagination = &Pagination{Next: strconv.Itoa(end)}
+	}
+	return resp, nil
+}
+
+// mockScore scores a stored vector against a query vector like Pinecone does for the metric.
+func mockScore(metric Metric, query []float32, values []float32) float32 {
+	var dot, queryNorm, valuesNorm, distance float64
+	for i := range query {
+		if i >= len(values) {
+			break
+		}
+		q, v := float64(query[i]), float64(values[i])
+		dot += q * v
+		queryNorm += q * q
+		valuesNorm += v * v
+		distance += (q - v) * (q - v)
+	}
+
+	switch metric {
+	case MetricEuclidean:
+		return float32(distance)
+	case MetricDotProduct:
+		return float32(dot)
+	default:
+		if queryNorm == 0 || valuesNorm == 0 {
+			return 0
+		}
+		return float32(dot / (math.Sqrt(queryNorm) * math.Sqrt(valuesNorm)))
+	}
+}
+
+// mockSparseScore returns the dot product of two sparse vectors, which hybrid
+// queries of dotproduct indexes add to the dense score.
+func mockSparseScore(query *SparseValues, values *SparseValues) float32 {
+	if query == nil || values == nil {
+		return 0
+	}
+
+	stored := make(map[uint32]float32, len(values.Indices))
+	for i, index := range values.Indices {
+		stored[index] = values.Values[i]
+	}
+	var dot float32
+	for i, index := range query.Indices {
+		dot += query.Values[i] * stored[index]
+	}
+	return dot
+}
+
+// mockMatchesFilter evaluates a metadata filter against vector metadata.
+func mockMatchesFilter(metadata map[string]any, filter map[string]any) bool {
+	for key, condition := range filter {
+		switch key {
+		case "$and":
+			clauses, _ := condition.([]any)
+			for _, clause := range clauses {
+				clauseFilter, _ := clause.(map[string]any)
+				if !mockMatchesFilter(metadata, clauseFilter) {
+					return false
+				}
+			}
+		case "$or":
+			clauses, _ := condition.([]any)
+			matched := false
+			for _, clause := range clauses {
+				clauseFilter, _ := clause.(map[string]any)
+				if mockMatchesFilter(metadata, clauseFilter) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+		default:
+			operators, ok := condition.(map[string]any)
+			if !ok {
+				operators = map[string]any{"$eq": condition}
+			}
+			value, exists := metadata[key]
+			for operator, operand := range operators {
+				if !mockMatchesOperator(operator, value, exists, operand) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func mockMatchesOperator(operator string, value any, exists bool, operand any) bool {
+	switch operator {
+	case "$eq":
+		return exists && mockEqual(value, operand)
+	case "$ne":
+		return !exists || !mockEqual(value, operand)
+	case "$in", "$nin":
+		operands, _ := operand.([]any)
+		found := false
+		for _, o := range operands {
+			if exists && mockEqual(value, o) {
+				found = true
+				break
+			}
+		}
+		return found == (operator == "$in")
+	case "$exists":
+		want, _ := operand.(bool)
+		return exists == want
+	case "$gt", "$gte", "$lt", "$lte":
+		v, ok1 := mockNumber(value)
+		o, ok2 := mockNumber(operand)
+		if !exists || !ok1 || !ok2 {
+			return false
+		}
+		switch operator {
+		case "$gt":
+			return v > o
+		case "$gte":
+			return v >= o
+		case "$lt":
+			return v < o
+		default:
+			return v <= o
+		}
+	}
+	return false
+}
+
+// mockEqual compares metadata values; list values match when they contain the operand.
+func mockEqual(value any, operand any) bool {
+	if list, ok := value.([]any); ok {
+		for _, item := range list {
+			if mockEqual(item, operand) {
+				return true
+			}
+		}
+		return false
+	}
+	if v, ok := mockNumber(value); ok {
+		o, ok := mockNumber(operand)
+		return ok && v == o
+	}
+	return value == operand
+}
+
+func mockNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
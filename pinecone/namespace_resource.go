@@ -0,0 +1,240 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &namespaceResource{}
+	_ resource.ResourceWithConfigure   = &namespaceResource{}
+	_ resource.ResourceWithImportState = &namespaceResource{}
+)
+
+// NewNamespaceResource is a helper function to simplify the provider implementation.
+func NewNamespaceResource() resource.Resource {
+	return &namespaceResource{}
+}
+
+// namespaceResource is the resource implementation.
+type namespaceResource struct {
+	client PineconeClientInterface
+}
+
+type namespaceResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	IndexName   types.String `tfsdk:"index_name"`
+	Namespace   types.String `tfsdk:"namespace"`
+	VectorCount types.Int64  `tfsdk:"vector_count"`
+}
+
+// namespaceID returns the ID of a namespace, index_name/namespace.
+func namespaceID(indexName string, namespace string) string {
+	return indexName + "/" + namespace
+}
+
+// parseNamespaceID splits an index_name/namespace ID. Index names cannot hold
+// a slash, so everything after the first one is the namespace.
+func parseNamespaceID(id string) (string, string, error) {
+	indexName, namespace, ok := strings.Cut(id, "/")
+	if !ok || indexName == "" {
+		return "", "", fmt.Errorf("error: invalid namespace ID %q, must be index_name/namespace", id)
+	}
+	return indexName, namespace, nil
+}
+
+// Metadata returns the resource type name.
+func (r *namespaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace"
+}
+
+// Schema defines the schema for the resource.
+func (r *namespaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a namespace of an index. Pinecone creates namespaces on the first upsert, " +
+			"so creating the resource only checks the index exists; destroying it deletes every vector of the namespace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the namespace, index_name/namespace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The name of the namespace. \"\" is the default namespace.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vector_count": schema.Int64Attribute{
+				Description: "The number of vectors in the namespace.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// readNamespace sets the vector count of the namespace. It returns false if the index does not exist.
+func (r *namespaceResource) readNamespace(ctx context.Context, model *namespaceResourceModel) (bool, error) {
+	index, err := r.client.DescribeIndex(ctx, model.IndexName.ValueString())
+	if err != nil || index == nil {
+		return false, err
+	}
+
+	stats, err := r.client.DescribeIndexStats(ctx, model.IndexName.ValueString(), DescribeIndexStatsRequest{})
+	if err != nil {
+		return false, err
+	}
+
+	// An empty namespace is not listed in the stats.
+	model.ID = types.StringValue(namespaceID(model.IndexName.ValueString(), model.Namespace.ValueString()))
+	model.VectorCount = types.Int64Value(int64(stats.Namespaces[model.Namespace.ValueString()].VectorCount))
+	return true, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *namespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan namespaceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.readNamespace(ctx, &plan)
+	if err == nil && !found {
+		err = fmt.Errorf("error: index %s not found", plan.IndexName.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating namespace",
+			"Could not create namespace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *namespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state namespaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.readNamespace(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Namespace",
+			"Could not read Pinecone Namespace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// If the index is gone, then so is the namespace
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as changing any argument replaces the namespace.
+func (r *namespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan namespaceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *namespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state namespaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The vectors are already gone if the index is.
+	index, err := r.client.DescribeIndex(ctx, state.IndexName.ValueString())
+	if err == nil && index != nil {
+		err = r.client.Delete(ctx, state.IndexName.ValueString(), DeleteRequest{
+			DeleteAll: true,
+			Namespace: state.Namespace.ValueString(),
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting namespace",
+			"Could not delete namespace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *namespaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+}
+
+func (r *namespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	indexName, namespace, err := parseNamespaceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index_name"), indexName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+}
@@ -0,0 +1,115 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestParseNamespaceID(t *testing.T) {
+	tests := []struct {
+		id        string
+		indexName string
+		namespace string
+	}{
+		{"test/tenant-a", "test", "tenant-a"},
+		{"test/", "test", ""},
+		{"test/a/b", "test", "a/b"},
+	}
+	for _, test := range tests {
+		indexName, namespace, err := parseNamespaceID(test.id)
+		if err != nil || indexName != test.indexName || namespace != test.namespace {
+			t.Fatalf("expected %q and %q for %q, but received %q, %q, %v", test.indexName, test.namespace, test.id, indexName, namespace, err)
+		}
+	}
+	for _, id := range []string{"test", "/tenant-a", ""} {
+		if _, _, err := parseNamespaceID(id); err == nil {
+			t.Fatalf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccNamespaceResource(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2})
+	for _, namespace := range []string{"tenant-a", "tenant-b"} {
+		_, _ = cli.Upsert(ctx, "test", UpsertRequest{Namespace: namespace, Vectors: []Vector{
+			{ID: "a", Values: []float32{1, 0}},
+			{ID: "b", Values: []float32{0, 1}},
+		}})
+	}
+
+	namespaceCount := func(namespace string, expected int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			stats, err := cli.DescribeIndexStats(ctx, "test", DescribeIndexStatsRequest{})
+			if err != nil {
+				return err
+			}
+			if count := stats.Namespaces[namespace].VectorCount; count != expected {
+				return fmt.Errorf("expected %d vectors in namespace %s, but found %d", expected, namespace, count)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			namespaceCount("tenant-a", 0),
+			namespaceCount("tenant-b", 2),
+		),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "pinecone_namespace" "test" {
+    index_name = "test"
+    namespace  = "tenant-a"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_namespace.test", "id", "test/tenant-a"),
+					resource.TestCheckResourceAttr("pinecone_namespace.test", "vector_count", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pinecone_namespace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "pinecone_namespace.test",
+				ImportState:   true,
+				ImportStateId: "test",
+				ExpectError:   regexp.MustCompile(`must\s+be\s+index_name/namespace`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNamespaceResourceMissingIndex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pinecone_namespace" "test" {
+    index_name = "missing"
+    namespace  = "tenant-a"
+}
+`,
+				ExpectError: regexp.MustCompile(`index\s+missing\s+not\s+found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+package pinecone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTokenURL is the OAuth token endpoint of Pinecone service accounts.
+	DefaultTokenURL = "https://login.pinecone.io/oauth/token"
+	// DefaultTokenAudience is the audience of tokens for the Pinecone APIs.
+	DefaultTokenAudience = "https://api.pinecone.io/"
+)
+
+// tokenRefreshMargin is how long before expiry a cached token is refreshed, so
+// that a token does not expire while a request is in flight.
+const tokenRefreshMargin = time.Minute
+
+// defaultTokenLifetime is how long a token is cached when the token endpoint
+// does not say when it expires.
+const defaultTokenLifetime = 30 * time.Minute
+
+// TokenSource fetches short-lived bearer tokens with the OAuth client-credentials
+// grant of a service account, and caches each token until shortly before it expires.
+// It is safe for concurrent use.
+type TokenSource struct {
+	ClientID     string
+	ClientSecret string
+	// TokenURL defaults to DefaultTokenURL.
+	TokenURL string
+	// Audience defaults to DefaultTokenAudience.
+	Audience string
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// now is replaced in tests.
+	now func() time.Time
+
+	mutex  sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func NewTokenSource(clientID string, clientSecret string) *TokenSource {
+	return &TokenSource{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     DefaultTokenURL,
+		Audience:     DefaultTokenAudience,
+	}
+}
+
+type tokenRequest struct {
+	GrantType    string `json:"grant_type"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Audience     string `json:"audience"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (s *TokenSource) clock() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+// Token returns a valid bearer token, fetching a new one if the cached token is missing or about to expire.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token != "" && s.clock().Add(tokenRefreshMargin).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	resp, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	lifetime := time.Duration(resp.ExpiresIn) * time.Second
+	if resp.ExpiresIn <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+	s.token = resp.AccessToken
+	s.expiry = s.clock().Add(lifetime)
+	return s.token, nil
+}
+
+// fetch requests a new token from the token endpoint.
+func (s *TokenSource) fetch(ctx context.Context) (*tokenResponse, error) {
+	tokenURL := s.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultTokenURL
+	}
+	audience := s.Audience
+	if audience == "" {
+		audience = DefaultTokenAudience
+	}
+
+	body, err := json.Marshal(tokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+		Audience:     audience,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Add("accept", "application/json")
+	httpReq.Header.Add("content-type", "application/json")
+
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	// The response may echo the request, so the body is not included for failed requests.
+	if res.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("error: fetching an OAuth token for client %s failed with status code: %d", s.ClientID, res.StatusCode)
+	}
+
+	var resp tokenResponse
+	if err := json.Unmarshal(resBody, &resp); err != nil {
+		return nil, err
+	}
+	if resp.AccessToken == "" {
+		return nil, fmt.Errorf("error: the OAuth token response for client %s has no access_token", s.ClientID)
+	}
+	if resp.TokenType != "" && !strings.EqualFold(resp.TokenType, "bearer") {
+		return nil, fmt.Errorf("error: unsupported OAuth token type: %s", resp.TokenType)
+	}
+	return &resp, nil
+}
+
+// setAuthHeader authenticates a request with a bearer token from tokens if set,
+// and otherwise with the API key.
+func setAuthHeader(ctx context.Context, req *http.Request, apiKey string, tokens *TokenSource) error {
+	if tokens == nil {
+		req.Header.Add("Api-Key", apiKey)
+		return nil
+	}
+
+	token, err := tokens.Token(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+	return nil
+}
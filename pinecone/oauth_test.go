@@ -0,0 +1,227 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// newTestTokenEndpoint serves tokens for client "id" with secret "secret", numbered by request.
+func newTestTokenEndpoint(t *testing.T, expiresIn int) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req tokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("invalid token request: %v", err)
+		}
+		if req.GrantType != "client_credentials" || req.ClientID != "id" || req.ClientSecret != "secret" || req.Audience != DefaultTokenAudience {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"access_denied"}`))
+			return
+		}
+		requests++
+		_ = json.NewEncoder(w).Encode(tokenResponse{
+			AccessToken: fmt.Sprintf("token-%d", requests),
+			TokenType:   "Bearer",
+			ExpiresIn:   expiresIn,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestTokenSource(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newTestTokenEndpoint(t, 3600)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tokens := NewTokenSource("id", "secret")
+	tokens.TokenURL = server.URL
+	tokens.HTTPClient = server.Client()
+	tokens.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		token, err := tokens.Token(ctx)
+		if err != nil || token != "token-1" {
+			t.Fatalf("expected the cached token-1, but received %q, %v", token, err)
+		}
+	}
+	if *requests != 1 {
+		t.Fatalf("expected 1 token request, but received %d", *requests)
+	}
+
+	// A token is refreshed shortly before it expires.
+	now = now.Add(time.Hour - tokenRefreshMargin)
+	token, err := tokens.Token(ctx)
+	if err != nil || token != "token-2" {
+		t.Fatalf("expected a refreshed token-2, but received %q, %v", token, err)
+	}
+
+	invalid := NewTokenSource("id", "wrong")
+	invalid.TokenURL = server.URL
+	_, err = invalid.Token(ctx)
+	if err == nil || err.Error() != "error: fetching an OAuth token for client id failed with status code: 401" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTokenSourceWithoutExpiry(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newTestTokenEndpoint(t, 0)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tokens := NewTokenSource("id", "secret")
+	tokens.TokenURL = server.URL
+	tokens.HTTPClient = server.Client()
+	tokens.now = func() time.Time { return now }
+
+	// A token without expires_in is cached for the default lifetime.
+	for i := 0; i < 2; i++ {
+		token, err := tokens.Token(ctx)
+		if err != nil || token != "token-1" {
+			t.Fatalf("expected the cached token-1, but received %q, %v", token, err)
+		}
+	}
+	if *requests != 1 {
+		t.Fatalf("expected 1 token request, but received %d", *requests)
+	}
+
+	now = now.Add(defaultTokenLifetime - tokenRefreshMargin)
+	token, err := tokens.Token(ctx)
+	if err != nil || token != "token-2" {
+		t.Fatalf("expected a refreshed token-2, but received %q, %v", token, err)
+	}
+}
+
+// rewriteTransport sends every request to the test server, keeping the path.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientsWithTokens(t *testing.T) {
+	ctx := context.Background()
+	tokenServer, _ := newTestTokenEndpoint(t, 3600)
+
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "" {
+			t.Errorf("expected no Api-Key header with tokens, but received %q", r.Header.Get("Api-Key"))
+		}
+		authorizations = append(authorizations, r.URL.Path+" "+r.Header.Get("Authorization"))
+
+		switch {
+		case r.URL.Path == "/databases":
+			_, _ = w.Write([]byte(`["test"]`))
+		case r.URL.Path == "/databases/test":
+			_, _ = w.Write([]byte(`{"database":{"name":"test","dimension":2},"status":{"host":"test-host","ready":true}}`))
+		case r.URL.Path == "/query":
+			_, _ = w.Write([]byte(`{"matches":[],"namespace":""}`))
+		case strings.HasPrefix(r.URL.Path, "/admin/projects"):
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	target, _ := url.Parse(server.URL)
+
+	tokens := NewTokenSource("id", "secret")
+	tokens.TokenURL = tokenServer.URL
+
+	cli, err := NewClientWithTokens(tokens, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli.HTTPClient = &http.Client{Transport: &rewriteTransport{target: target}}
+	if _, err := cli.ListIndexes(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Query(ctx, "test", QueryRequest{TopK: 1, Vector: []float32{1, 0}}); err != nil {
+		t.Fatal(err)
+	}
+
+	admin := NewAdminClientWithTokens(tokens)
+	admin.BaseURL = server.URL + "/admin"
+	if _, err := admin.ListProjects(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"/databases Bearer token-1",
+		"/databases/test Bearer token-1",
+		"/query Bearer token-1",
+		"/admin/projects Bearer token-1",
+	}
+	if strings.Join(authorizations, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected requests %v, but received %v", expected, authorizations)
+	}
+}
+
+func TestAccProviderClientCredentials(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid credentials fail before any state exists, so they come first.
+			{
+				Config: `
+provider "pinecone" {
+    environment = "test"
+    client_id   = "id"
+}
+
+data "pinecone_index" "test" {
+    name = "test"
+}
+`,
+				ExpectError: regexp.MustCompile(`needs\s+both\s+client_id\s+and\s+client_secret`),
+			},
+			{
+				Config: `
+provider "pinecone" {
+    environment   = "test"
+    api_key       = "test_api_key"
+    client_id     = "id"
+    client_secret = "secret"
+}
+
+data "pinecone_index" "test" {
+    name = "test"
+}
+`,
+				ExpectError: regexp.MustCompile(`either\s+with\s+api_key\s+or\s+with\s+client_id\s+and\s+client_secret`),
+			},
+			{
+				Config: `
+provider "pinecone" {
+    environment   = "test"
+    client_id     = "id"
+    client_secret = "secret"
+}
+
+data "pinecone_index" "test" {
+    name = "test"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_index.test", "id", "test"),
+				),
+			},
+		},
+	})
+}
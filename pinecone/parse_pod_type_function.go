@@ -0,0 +1,75 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &parsePodTypeFunction{}
+)
+
+var podTypeAttributeTypes = map[string]attr.Type{
+	"class": types.StringType,
+	"size":  types.StringType,
+}
+
+// NewParsePodTypeFunction is a helper function to simplify the provider implementation.
+func NewParsePodTypeFunction() function.Function {
+	return &parsePodTypeFunction{}
+}
+
+// parsePodTypeFunction is the function implementation.
+type parsePodTypeFunction struct{}
+
+// Metadata returns the function name.
+func (f *parsePodTypeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_pod_type"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *parsePodTypeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a pod type.",
+		Description: "Split a pod type such as \"p1.x2\" into its class and size, failing if it is not a valid pod type.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "pod_type",
+				Description: "The pod type to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: podTypeAttributeTypes,
+		},
+	}
+}
+
+// Run parses the pod type.
+func (f *parsePodTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var podTypeStr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &podTypeStr))
+	if resp.Error != nil {
+		return
+	}
+
+	podType, err := NewPodType(podTypeStr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(podTypeAttributeTypes, map[string]attr.Value{
+		"class": types.StringValue(podType.Class),
+		"size":  types.StringValue(podType.Size),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
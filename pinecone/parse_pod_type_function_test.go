@@ -0,0 +1,55 @@
+package pinecone
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParsePodTypeFunction(t *testing.T) {
+	expected, _ := types.ObjectValue(podTypeAttributeTypes, map[string]attr.Value{
+		"class": types.StringValue("p1"),
+		"size":  types.StringValue("x2"),
+	})
+
+	result, err := runFunction(NewParsePodTypeFunction(), types.ObjectUnknown(podTypeAttributeTypes), types.StringValue("p1.x2"))
+	if err != nil {
+		t.Fatalf("expected no error, but received error: %v", err)
+	}
+	if !result.Equal(expected) {
+		t.Fatalf("expected %v, but received %v", expected, result)
+	}
+
+	if _, err := runFunction(NewParsePodTypeFunction(), types.ObjectUnknown(podTypeAttributeTypes), types.StringValue("p1.x3")); err == nil {
+		t.Fatal("expected an error for an invalid pod type")
+	}
+}
+
+func TestAccParsePodTypeFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "class" {
+    value = provider::pinecone::parse_pod_type("s1.x4").class
+}
+
+output "size" {
+    value = provider::pinecone::parse_pod_type("s1.x4").size
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("class", "s1"),
+					resource.TestCheckOutput("size", "x4"),
+				),
+			},
+		},
+	})
+}
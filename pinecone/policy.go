@@ -0,0 +1,116 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// IndexPolicy holds the provider-level cost guardrails applied to pinecone_index plans.
+// A zero limit means the limit is not enforced.
+type IndexPolicy struct {
+	AllowedPodTypes []PodType
+	MaxPodsPerIndex int
+	MaxReplicas     int
+	MaxTotalPods    int
+}
+
+// IndexPolicyViolation describes a single guardrail that a planned index breaks.
+type IndexPolicyViolation struct {
+	Attribute string
+	Message   string
+}
+
+// indexPodCount is the number of pods an index consumes: every replica is a full copy of its pods.
+func indexPodCount(pods int, replicas int) int {
+	return pods * replicas
+}
+
+// IsEmpty reports whether no guardrail is configured.
+func (p *IndexPolicy) IsEmpty() bool {
+	return p == nil || (len(p.AllowedPodTypes) == 0 && p.MaxPodsPerIndex == 0 && p.MaxReplicas == 0 && p.MaxTotalPods == 0)
+}
+
+// Check validates a single index against the per-index guardrails.
+func (p *IndexPolicy) Check(podType PodType, pods int, replicas int) []IndexPolicyViolation {
+	if p.IsEmpty() {
+		return nil
+	}
+
+	var violations []IndexPolicyViolation
+	if len(p.AllowedPodTypes) > 0 {
+		allowed := false
+		names := make([]string, len(p.AllowedPodTypes))
+		for i, t := range p.AllowedPodTypes {
+			names[i] = t.String()
+			if t == podType {
+				allowed = true
+			}
+		}
+		if !allowed {
+			violations = append(violations, IndexPolicyViolation{
+				Attribute: "pod_type",
+				Message:   fmt.Sprintf("pod type %s is not allowed, allowed_pod_types is [%s]", podType, strings.Join(names, ", ")),
+			})
+		}
+	}
+
+	if p.MaxReplicas > 0 && replicas > p.MaxReplicas {
+		violations = append(violations, IndexPolicyViolation{
+			Attribute: "replicas",
+			Message:   fmt.Sprintf("%d replicas exceeds max_replicas of %d", replicas, p.MaxReplicas),
+		})
+	}
+
+	if count := indexPodCount(pods, replicas); p.MaxPodsPerIndex > 0 && count > p.MaxPodsPerIndex {
+		violations = append(violations, IndexPolicyViolation{
+			Attribute: "pods",
+			Message:   fmt.Sprintf("index would use %d pods (%d pods x %d replicas), exceeding max_pods_per_index of %d", count, pods, replicas, p.MaxPodsPerIndex),
+		})
+	}
+
+	return violations
+}
+
+// CheckTotal validates the project-wide pod count. Indexes named in exclude are
+// skipped because the planned index replaces them. Only indexes that already
+// exist are counted, so other indexes planned alongside this one are not.
+func (p *IndexPolicy) CheckTotal(ctx context.Context, client PineconeClientInterface, pods int, replicas int, exclude ...string) ([]IndexPolicyViolation, error) {
+	if p == nil || p.MaxTotalPods == 0 {
+		return nil, nil
+	}
+
+	names, err := client.ListIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]bool, len(exclude))
+	for _, name := range exclude {
+		skip[name] = true
+	}
+
+	existing := 0
+	for _, name := range names {
+		if skip[name] {
+			continue
+		}
+		index, err := client.DescribeIndex(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if index == nil {
+			continue
+		}
+		existing += indexPodCount(index.Database.Pods, index.Database.Replicas)
+	}
+
+	planned := indexPodCount(pods, replicas)
+	if existing+planned > p.MaxTotalPods {
+		return []IndexPolicyViolation{{
+			Attribute: "pods",
+			Message:   fmt.Sprintf("project would use %d pods (%d in other indexes + %d in this index), exceeding max_total_pods of %d", existing+planned, existing, planned, p.MaxTotalPods),
+		}}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,139 @@
+package pinecone
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIndexPolicyCheck(t *testing.T) {
+	policy := &IndexPolicy{
+		AllowedPodTypes: []PodType{{Class: "s1", Size: "x1"}, {Class: "p1", Size: "x1"}},
+		MaxPodsPerIndex: 4,
+		MaxReplicas:     2,
+	}
+
+	testCases := []struct {
+		name       string
+		podType    PodType
+		pods       int
+		replicas   int
+		attributes []string
+	}{
+		{name: "Within policy", podType: PodType{Class: "p1", Size: "x1"}, pods: 2, replicas: 2},
+		{name: "Pod type not allowed", podType: PodType{Class: "p2", Size: "x8"}, pods: 1, replicas: 1, attributes: []string{"pod_type"}},
+		{name: "Too many replicas", podType: PodType{Class: "s1", Size: "x1"}, pods: 1, replicas: 3, attributes: []string{"replicas"}},
+		{name: "Too many pods", podType: PodType{Class: "s1", Size: "x1"}, pods: 3, replicas: 2, attributes: []string{"pods"}},
+		{name: "Everything wrong", podType: PodType{Class: "p2", Size: "x8"}, pods: 2, replicas: 10, attributes: []string{"pod_type", "replicas", "pods"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			violations := policy.Check(tc.podType, tc.pods, tc.replicas)
+			if len(violations) != len(tc.attributes) {
+				t.Fatalf("test '%s' failed: expected %d violations, but received %v", tc.name, len(tc.attributes), violations)
+			}
+			for i, v := range violations {
+				if v.Attribute != tc.attributes[i] {
+					t.Fatalf("test '%s' failed: expected violation on %s, but received %v", tc.name, tc.attributes[i], v)
+				}
+			}
+		})
+	}
+
+	var empty *IndexPolicy
+	if v := empty.Check(PodType{Class: "p2", Size: "x8"}, 100, 100); v != nil {
+		t.Fatalf("expected nil policy to allow everything, but received %v", v)
+	}
+}
+
+func TestIndexPolicyCheckTotal(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "a", Pods: 2, Replicas: 2})
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "b", Pods: 1, Replicas: 1})
+
+	policy := &IndexPolicy{MaxTotalPods: 6}
+
+	// 4 + 1 existing, 1 planned
+	violations, err := policy.CheckTotal(ctx, cli, 1, 1)
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("expected no violations, but received %v, %v", violations, err)
+	}
+
+	// 4 + 1 existing, 2 planned
+	violations, err = policy.CheckTotal(ctx, cli, 2, 1)
+	if err != nil || len(violations) != 1 {
+		t.Fatalf("expected one violation, but received %v, %v", violations, err)
+	}
+
+	// Replacing "a" frees its 4 pods
+	violations, err = policy.CheckTotal(ctx, cli, 2, 2, "a")
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("expected no violations when excluding a, but received %v, %v", violations, err)
+	}
+}
+
+func TestAccIndexResourcePolicy(t *testing.T) {
+	const policyProviderConfig = `
+provider "pinecone" {
+    environment        = "test"
+    api_key            = "test_api_key"
+    allowed_pod_types  = ["s1.x1", "p1.x1"]
+    max_replicas       = 2
+    max_pods_per_index = 2
+    max_total_pods     = 3
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: policyProviderConfig + `
+resource "pinecone_index" "test" {
+	name      = "test"
+	dimension = 1536
+	pod_type  = "p2.x8"
+	replicas  = 10
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`pod type p2.x8 is not allowed`),
+			},
+			{
+				Config: policyProviderConfig + `
+resource "pinecone_index" "test" {
+	name      = "test"
+	dimension = 1536
+	replicas  = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "replicas", "2"),
+				),
+			},
+			{
+				Config: policyProviderConfig + `
+resource "pinecone_index" "test" {
+	name      = "test"
+	dimension = 1536
+	replicas  = 2
+}
+
+resource "pinecone_index" "second" {
+	name      = "second"
+	dimension = 1536
+	pod_type  = "s1.x1"
+	replicas  = 2
+}
+`,
+				ExpectError: regexp.MustCompile(`exceeding max_total_pods of 3`),
+			},
+		},
+	})
+}
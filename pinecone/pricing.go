@@ -0,0 +1,93 @@
+package pinecone
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hoursPerMonth is the average number of hours in a month used for cost estimates.
+const hoursPerMonth = 730
+
+// defaultPodClassHourlyRates are the list prices in USD per hour of one x1 pod of each class.
+var defaultPodClassHourlyRates = map[string]float64{
+	"s1": 0.096,
+	"p1": 0.096,
+	"p2": 0.144,
+}
+
+// podSizeMultipliers is how many x1 pods each pod size is billed as.
+var podSizeMultipliers = map[string]int{
+	"x1": 1,
+	"x2": 2,
+	"x4": 4,
+	"x8": 8,
+}
+
+// PricingCatalog resolves the hourly rate of a pod type.
+// Overrides are keyed either by pod class ("p1"), giving the x1 rate, or by full pod type ("p1.x2").
+type PricingCatalog struct {
+	overrides map[string]float64
+}
+
+func NewPricingCatalog(overrides map[string]float64) (*PricingCatalog, error) {
+	for key, rate := range overrides {
+		if _, ok := defaultPodClassHourlyRates[key]; !ok {
+			if _, err := NewPodType(key); err != nil {
+				return nil, fmt.Errorf("error: invalid pricing override key %q: must be a pod class or pod type", key)
+			}
+		}
+		if rate < 0 {
+			return nil, fmt.Errorf("error: invalid pricing override for %s: rate must not be negative", key)
+		}
+	}
+	return &PricingCatalog{
+		overrides: overrides,
+	}, nil
+}
+
+// HourlyRate returns the USD per hour price of a single pod, or false when the pod type has no known price.
+func (c *PricingCatalog) HourlyRate(podType PodType) (float64, bool) {
+	if c != nil {
+		if rate, ok := c.overrides[podType.String()]; ok {
+			return rate, true
+		}
+	}
+
+	multiplier, ok := podSizeMultipliers[podType.Size]
+	if !ok {
+		return 0, false
+	}
+
+	if c != nil {
+		if rate, ok := c.overrides[podType.Class]; ok {
+			return rate * float64(multiplier), true
+		}
+	}
+
+	rate, ok := defaultPodClassHourlyRates[podType.Class]
+	if !ok {
+		return 0, false
+	}
+	return rate * float64(multiplier), true
+}
+
+// EstimateMonthlyCost returns the USD per month price of an index, rounded to cents.
+func (c *PricingCatalog) EstimateMonthlyCost(podType PodType, pods int, replicas int) (float64, bool) {
+	rate, ok := c.HourlyRate(podType)
+	if !ok {
+		return 0, false
+	}
+	cost := rate * hoursPerMonth * float64(indexPodCount(pods, replicas))
+	return math.Round(cost*100) / 100, true
+}
+
+// newTFEstimatedMonthlyCost maps an index's estimated cost to its Terraform value, null when the pod type has no known price.
+func newTFEstimatedMonthlyCost(pricing *PricingCatalog, podType PodType, pods int, replicas int) types.Float64 {
+	cost, ok := pricing.EstimateMonthlyCost(podType, pods, replicas)
+	if !ok {
+		return types.Float64Null()
+	}
+	return types.Float64Value(cost)
+}
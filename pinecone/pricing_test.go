@@ -0,0 +1,165 @@
+package pinecone
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	helperresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPricingCatalogEstimateMonthlyCost(t *testing.T) {
+	catalog, err := NewPricingCatalog(map[string]float64{
+		"s1":    0.1,
+		"p2.x8": 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		catalog  *PricingCatalog
+		podType  PodType
+		pods     int
+		replicas int
+		expected float64
+		ok       bool
+	}{
+		{name: "Default rate", catalog: nil, podType: PodType{Class: "p1", Size: "x1"}, pods: 1, replicas: 1, expected: 70.08, ok: true},
+		{name: "Default rate scaled by size and replicas", catalog: nil, podType: PodType{Class: "p2", Size: "x4"}, pods: 2, replicas: 3, expected: 2522.88, ok: true},
+		{name: "Class override", catalog: catalog, podType: PodType{Class: "s1", Size: "x2"}, pods: 1, replicas: 1, expected: 146, ok: true},
+		{name: "Pod type override", catalog: catalog, podType: PodType{Class: "p2", Size: "x8"}, pods: 1, replicas: 2, expected: 2920, ok: true},
+		{name: "Unpriced class", catalog: catalog, podType: PodType{Class: "s9", Size: "x1"}, pods: 1, replicas: 1, ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cost, ok := tc.catalog.EstimateMonthlyCost(tc.podType, tc.pods, tc.replicas)
+			if ok != tc.ok || cost != tc.expected {
+				t.Fatalf("test '%s' failed: expected (%v, %v), but received (%v, %v)", tc.name, tc.expected, tc.ok, cost, ok)
+			}
+		})
+	}
+}
+
+func TestNewPricingCatalogInvalid(t *testing.T) {
+	if _, err := NewPricingCatalog(map[string]float64{"x9": 1}); err == nil {
+		t.Fatal("expected an error for an invalid override key")
+	}
+	if _, err := NewPricingCatalog(map[string]float64{"p1": -1}); err == nil {
+		t.Fatal("expected an error for a negative rate")
+	}
+}
+
+func TestAccIndexResourcePricingOverride(t *testing.T) {
+	helperresource.Test(t, helperresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []helperresource.TestStep{
+			{
+				Config: `
+provider "pinecone" {
+    environment      = "test"
+    api_key          = "test_api_key"
+    pricing_override = { "p1" = 1 }
+}
+
+resource "pinecone_index" "test" {
+	name      = "test"
+	dimension = 1536
+	replicas  = 2
+}
+
+data "pinecone_index" "test" {
+	name = pinecone_index.test.name
+}
+`,
+				Check: helperresource.ComposeAggregateTestCheckFunc(
+					helperresource.TestCheckResourceAttr("pinecone_index.test", "estimated_monthly_cost_usd", "1460"),
+					helperresource.TestCheckResourceAttr("data.pinecone_index.test", "estimated_monthly_cost_usd", "1460"),
+				),
+			},
+		},
+	})
+}
+
+func TestIndexResourceModifyPlanCostIncreaseWarning(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&indexResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	// newPlan returns a plan of a p1.x1 index, which costs $70.08 per month per replica.
+	newPlan := func(replicas int64) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := plan.Set(ctx, indexResourceModel{
+			ID:                      types.StringValue("test"),
+			Name:                    types.StringValue("test"),
+			Dimension:               types.Int64Value(8),
+			Metric:                  types.StringValue("cosine"),
+			Pods:                    types.Int64Value(1),
+			Replicas:                types.Int64Value(replicas),
+			PodType:                 types.StringValue("p1.x1"),
+			MetadataConfig:          types.ObjectNull(metadataConfigAttributeTypes),
+			Embed:                   types.ObjectNull(embedAttributeTypes),
+			LastUpdated:             types.StringNull(),
+			EstimatedMonthlyCostUSD: newTFEstimatedMonthlyCost(nil, PodType{Class: "p1", Size: "x1"}, 1, int(replicas)),
+		})
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return plan
+	}
+
+	testCases := []struct {
+		name          string
+		stateReplicas int64
+		replicas      int64
+		threshold     float64
+		warning       string
+	}{
+		{name: "Increase over the threshold", stateReplicas: 1, replicas: 4, threshold: 200, warning: "up $210.24 from $70.08"},
+		{name: "Increase under the threshold", stateReplicas: 1, replicas: 4, threshold: 250},
+		{name: "Decrease", stateReplicas: 4, replicas: 1, threshold: 0},
+		{name: "New index over the threshold", replicas: 1, threshold: 50, warning: "up $70.08 from $0.00"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := newPlan(tc.replicas)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if tc.stateReplicas > 0 {
+				state.Raw = newPlan(tc.stateReplicas).Raw
+			}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+
+			threshold := tc.threshold
+			r := &indexResource{costIncreaseWarningUSD: &threshold}
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("test '%s' failed: unexpected errors %v", tc.name, resp.Diagnostics)
+			}
+
+			var warnings []string
+			for _, d := range resp.Diagnostics.Warnings() {
+				if d.Summary() == "Estimated cost increase" {
+					warnings = append(warnings, d.Detail())
+				}
+			}
+			if tc.warning == "" && len(warnings) != 0 {
+				t.Fatalf("test '%s' failed: expected no cost warning, but received %v", tc.name, warnings)
+			}
+			if tc.warning != "" && (len(warnings) != 1 || !strings.Contains(warnings[0], tc.warning)) {
+				t.Fatalf("test '%s' failed: expected a cost warning with %q, but received %v", tc.name, tc.warning, warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &projectResource{}
+	_ resource.ResourceWithConfigure      = &projectResource{}
+	_ resource.ResourceWithImportState    = &projectResource{}
+	_ resource.ResourceWithValidateConfig = &projectResource{}
+	_ resource.ResourceWithModifyPlan     = &projectResource{}
+)
+
+// NewProjectResource is a helper function to simplify the provider implementation.
+func NewProjectResource() resource.Resource {
+	return &projectResource{}
+}
+
+// projectResource is the resource implementation.
+type projectResource struct {
+	client AdminClientInterface
+}
+
+type projectResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	MaxPods                 types.Int64  `tfsdk:"max_pods"`
+	ForceEncryptionWithCMEK types.Bool   `tfsdk:"force_encryption_with_cmek"`
+	OrganizationID          types.String `tfsdk:"organization_id"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+}
+
+// setProject sets the model from a project returned by the admin API.
+func (m *projectResourceModel) setProject(project *Project) {
+	m.ID = types.StringValue(project.ID)
+	m.Name = types.StringValue(project.Name)
+	m.MaxPods = types.Int64Value(int64(project.MaxPods))
+	m.ForceEncryptionWithCMEK = types.BoolValue(project.ForceEncryptionWithCMEK)
+	m.OrganizationID = types.StringValue(project.OrganizationID)
+	m.CreatedAt = types.StringValue(project.CreatedAt)
+}
+
+// Metadata returns the resource type name.
+func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a project of the organization through the admin API. " +
+			"Pinecone refuses to delete a project that still has indexes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the project.",
+				Required:    true,
+			},
+			"max_pods": schema.Int64Attribute{
+				Description: "The maximum number of pods all indexes of the project may use. Defaults to the organization's quota.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_encryption_with_cmek": schema.BoolAttribute{
+				Description: "Whether indexes of the project must be encrypted with a customer-managed encryption key. " +
+					"Once enabled it cannot be disabled. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization that owns the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the project was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that max_pods is not negative.
+func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var maxPods types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_pods"), &maxPods)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !maxPods.IsNull() && !maxPods.IsUnknown() && maxPods.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_pods"), "Invalid max_pods",
+			fmt.Sprintf("max_pods must not be negative, got %d.", maxPods.ValueInt64()))
+	}
+}
+
+// ModifyPlan fails the plan when it would disable CMEK encryption, which Pinecone does not allow.
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan projectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ForceEncryptionWithCMEK.ValueBool() && !plan.ForceEncryptionWithCMEK.IsUnknown() && !plan.ForceEncryptionWithCMEK.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("force_encryption_with_cmek"),
+			"Cannot disable CMEK encryption",
+			fmt.Sprintf("Project %s forces CMEK encryption, which cannot be disabled once enabled. "+
+				"Create a new project to use indexes without it.", state.Name.ValueString()),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := CreateProjectRequest{
+		Name:                    plan.Name.ValueString(),
+		ForceEncryptionWithCMEK: plan.ForceEncryptionWithCMEK.ValueBool(),
+	}
+	if !plan.MaxPods.IsUnknown() && !plan.MaxPods.IsNull() {
+		maxPods := int(plan.MaxPods.ValueInt64())
+		createReq.MaxPods = &maxPods
+	}
+
+	project, err := r.client.CreateProject(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project",
+			"Could not create project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.setProject(project)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.DescribeProject(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Project",
+			"Could not read Pinecone Project ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// If the project is not found, remove it from the state
+	if project == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.setProject(project)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state projectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send what changed
+	var updateReq UpdateProjectRequest
+	if !plan.Name.Equal(state.Name) {
+		updateReq.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.MaxPods.IsUnknown() && !plan.MaxPods.Equal(state.MaxPods) {
+		maxPods := int(plan.MaxPods.ValueInt64())
+		updateReq.MaxPods = &maxPods
+	}
+	if !plan.ForceEncryptionWithCMEK.Equal(state.ForceEncryptionWithCMEK) {
+		updateReq.ForceEncryptionWithCMEK = plan.ForceEncryptionWithCMEK.ValueBoolPointer()
+	}
+
+	project, err := r.client.UpdateProject(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project",
+			"Could not update project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.setProject(project)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state projectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteProject(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project",
+			"Could not delete project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.adminClient
+}
+
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
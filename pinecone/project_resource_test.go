@@ -0,0 +1,118 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProjectResource(t *testing.T) {
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := NewMockAdminClient()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithAdminClient(cli, admin),
+		CheckDestroy: func(s *terraform.State) error {
+			projects, err := admin.ListProjects(context.Background())
+			if err != nil {
+				return err
+			}
+			if len(projects) != 0 {
+				return fmt.Errorf("expected no projects, but found %+v", projects)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "pinecone_project" "test" {
+    name     = "search"
+    max_pods = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_project.test", "id", "mock-project-1"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "name", "search"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "max_pods", "5"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "force_encryption_with_cmek", "false"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "organization_id", "mock-org"),
+					resource.TestCheckResourceAttrSet("pinecone_project.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pinecone_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "pinecone_project" "test" {
+    name                       = "search-prod"
+    max_pods                   = 10
+    force_encryption_with_cmek = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_project.test", "id", "mock-project-1"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "name", "search-prod"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "max_pods", "10"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "force_encryption_with_cmek", "true"),
+				),
+			},
+			// CMEK encryption cannot be disabled
+			{
+				Config: providerConfig + `
+resource "pinecone_project" "test" {
+    name     = "search-prod"
+    max_pods = 10
+}
+`,
+				ExpectError: regexp.MustCompile(`Project\s+search-prod\s+forces\s+CMEK\s+encryption,\s+which\s+cannot\s+be\s+disabled`),
+			},
+			// A project deleted outside Terraform is created again
+			{
+				PreConfig: func() {
+					_ = admin.DeleteProject(context.Background(), "mock-project-1")
+				},
+				Config: providerConfig + `
+resource "pinecone_project" "test" {
+    name                       = "search-prod"
+    force_encryption_with_cmek = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_project.test", "id", "mock-project-2"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "max_pods", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pinecone_project" "test" {
+    name     = "search"
+    max_pods = -1
+}
+`,
+				ExpectError: regexp.MustCompile(`max_pods\s+must\s+not\s+be\s+negative,\s+got\s+-1`),
+			},
+		},
+	})
+}
@@ -3,8 +3,12 @@ package pinecone
 import (
 	"context"
 	"os"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -15,7 +19,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &pineconeProvider{}
+	_ provider.Provider                       = &pineconeProvider{}
+	_ provider.ProviderWithFunctions          = &pineconeProvider{}
+	_ provider.ProviderWithEphemeralResources = &pineconeProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -28,12 +34,47 @@ func New(cli PineconeClientInterface) provider.Provider {
 // pineconeProvider is the provider implementation.
 type pineconeProvider struct {
 	client PineconeClientInterface
+
+	// adminClient serves the admin API. It defaults to an AdminClient with the provider's credentials.
+	adminClient AdminClientInterface
+
+	// newClient creates clients for other credentials, such as the source of pinecone_index_copy.
+	// tokens is set instead of apiKey for the provider's OAuth credentials. It defaults to
+	// newClientWithCredentials.
+	newClient func(apiKey string, tokens *TokenSource, environment string) (PineconeClientInterface, error)
 }
 
 // hashicupsProviderModel maps provider schema data to a Go type.
 type pineconeProviderModel struct {
 	Environment types.String `tfsdk:"environment"`
 	ApiKey      types.String `tfsdk:"api_key"`
+	ReadOnly    types.Bool   `tfsdk:"read_only"`
+
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+
+	AllowedPodTypes types.List  `tfsdk:"allowed_pod_types"`
+	MaxPodsPerIndex types.Int64 `tfsdk:"max_pods_per_index"`
+	MaxReplicas     types.Int64 `tfsdk:"max_replicas"`
+	MaxTotalPods    types.Int64 `tfsdk:"max_total_pods"`
+
+	PricingOverride        types.Map     `tfsdk:"pricing_override"`
+	CostIncreaseWarningUSD types.Float64 `tfsdk:"cost_increase_warning_usd"`
+}
+
+// pineconeProviderData is passed to data sources and resources on Configure.
+type pineconeProviderData struct {
+	client      PineconeClientInterface
+	adminClient AdminClientInterface
+	policy      *IndexPolicy
+	pricing     *PricingCatalog
+
+	// costIncreaseWarningUSD is nil when cost increase warnings are disabled.
+	costIncreaseWarningUSD *float64
+
+	// newClient creates a client for other credentials, read-only if the provider is.
+	// An empty apiKey stands for the provider's own API key or OAuth credentials.
+	newClient func(apiKey string, environment string) (PineconeClientInterface, error)
 }
 
 // Metadata returns the provider type name.
@@ -51,10 +92,56 @@ func (p *pineconeProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "The Pinecone API key to use.",
+				Description: "The Pinecone API key to use. May be set from ephemeral.pinecone_api_key with Terraform 1.10 or later.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"client_id": schema.StringAttribute{
+				Description: "The client ID of a service account. With client_secret, the provider authenticates with " +
+					"short-lived OAuth tokens instead of api_key. May also be set with the PINECONE_CLIENT_ID environment variable.",
+				Optional: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "The client secret of the service account of client_id. " +
+					"May also be set with the PINECONE_CLIENT_SECRET environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Reject every call that would create, change or delete Pinecone resources. " +
+					"Reads still work, so plans can run safely with production credentials. " +
+					"May also be set with the PINECONE_READ_ONLY environment variable.",
+				Optional: true,
+			},
+			"allowed_pod_types": schema.ListAttribute{
+				Description: "Policy: the only pod types pinecone_index may use, e.g. [\"s1.x1\", \"p1.x1\"].",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"max_pods_per_index": schema.Int64Attribute{
+				Description: "Policy: the maximum pods x replicas a single pinecone_index may use.",
+				Optional:    true,
+			},
+			"max_replicas": schema.Int64Attribute{
+				Description: "Policy: the maximum replicas a single pinecone_index may use.",
+				Optional:    true,
+			},
+			"max_total_pods": schema.Int64Attribute{
+				Description: "Policy: the maximum pods x replicas summed over every index in the project, counting existing indexes. " +
+					"Each planned index is checked against the existing indexes only, so several new indexes in one plan can each pass " +
+					"while together exceeding the limit.",
+				Optional: true,
+			},
+			"pricing_override": schema.MapAttribute{
+				Description: "Hourly USD price of one pod, used instead of the built-in list prices for estimated_monthly_cost_usd. " +
+					"Keys are a pod class (\"p1\", the price of an x1 pod) or a full pod type (\"p1.x2\").",
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
+			"cost_increase_warning_usd": schema.Float64Attribute{
+				Description: "Warn during plan when a pinecone_index change raises its estimated monthly cost by more than this many USD.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -92,12 +179,46 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.ClientID.IsUnknown() || config.ClientSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Unknown pinecone client credentials",
+			"The provider cannot create the pinecone API client as there is an unknown configuration value for client_id or client_secret. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the PINECONE_CLIENT_ID and PINECONE_CLIENT_SECRET environment variables.",
+		)
+	}
+
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown pinecone read_only",
+			"The provider cannot create the pinecone API client as there is an unknown configuration value for read_only. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the PINECONE_READ_ONLY environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	apiKey := os.Getenv("PINECONE_API_KEY")
 	environment := os.Getenv("PINECONE_ENVIRONMENT")
+	clientID := os.Getenv("PINECONE_CLIENT_ID")
+	clientSecret := os.Getenv("PINECONE_CLIENT_SECRET")
+
+	var readOnly bool
+	if v := os.Getenv("PINECONE_READ_ONLY"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid PINECONE_READ_ONLY value",
+				"The PINECONE_READ_ONLY environment variable must be a boolean such as true or false, got: "+v,
+			)
+			return
+		}
+		readOnly = parsed
+	}
 
 	if !config.ApiKey.IsNull() {
 		apiKey = config.ApiKey.ValueString()
@@ -107,6 +228,44 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 		environment = config.Environment.ValueString()
 	}
 
+	if !config.ClientID.IsNull() {
+		clientID = config.ClientID.ValueString()
+	}
+
+	if !config.ClientSecret.IsNull() {
+		clientSecret = config.ClientSecret.ValueString()
+	}
+
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+
+	policy := newIndexPolicy(ctx, config, &resp.Diagnostics)
+
+	if config.PricingOverride.IsUnknown() || config.CostIncreaseWarningUSD.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown pinecone pricing configuration",
+			"The provider cannot estimate costs as pricing_override or cost_increase_warning_usd is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	var pricingOverride map[string]float64
+	resp.Diagnostics.Append(config.PricingOverride.ElementsAs(ctx, &pricingOverride, false)...)
+	pricing, err := NewPricingCatalog(pricingOverride)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pricing_override"),
+			"Invalid pinecone pricing_override",
+			err.Error(),
+		)
+	}
+
+	var costIncreaseWarningUSD *float64
+	if !config.CostIncreaseWarningUSD.IsNull() {
+		costIncreaseWarningUSD = config.CostIncreaseWarningUSD.ValueFloat64Pointer()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -120,12 +279,30 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
-	if apiKey == "" {
+	if (clientID == "") != (clientSecret == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Incomplete Pinecone client credentials",
+			"The provider needs both client_id and client_secret to authenticate as a service account. "+
+				"Set both in the configuration or with the PINECONE_CLIENT_ID and PINECONE_CLIENT_SECRET environment variables.",
+		)
+	}
+
+	if clientID != "" && !config.ApiKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Conflicting Pinecone credentials",
+			"The provider authenticates either with api_key or with client_id and client_secret. Remove one of them from the configuration.",
+		)
+	}
+
+	if apiKey == "" && clientID == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			"Missing Pinecone API Key",
 			"The provider cannot create the Pinecone API client as there is a missing or empty value for the Pinecone API Key. "+
-				"Set the api_key value in the configuration or use the PINECONE_API_KEY environment variable. "+
+				"Set the api_key value in the configuration or use the PINECONE_API_KEY environment variable, "+
+				"or authenticate as a service account with client_id and client_secret. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -136,12 +313,24 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 
 	ctx = tflog.SetField(ctx, "pinecone_api_key", apiKey)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pinecone_api_key")
+	ctx = tflog.SetField(ctx, "pinecone_client_id", clientID)
 	ctx = tflog.SetField(ctx, "pinecone_environment", environment)
+	ctx = tflog.SetField(ctx, "pinecone_read_only", readOnly)
 
 	tflog.Debug(ctx, "Creating Pinecone client")
 
-	// Create a Pinecone API client using the configuration values.
-	client, err := NewClient(apiKey, environment)
+	// Create a Pinecone API client using the configuration values. Client
+	// credentials take precedence over an API key from the environment.
+	var tokens *TokenSource
+	if clientID != "" {
+		tokens = NewTokenSource(clientID, clientSecret)
+	}
+	var client *PineconeClient
+	if tokens != nil {
+		client, err = NewClientWithTokens(tokens, environment)
+	} else {
+		client, err = NewClient(apiKey, environment)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Pinecone API Client",
@@ -152,23 +341,150 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	var cli PineconeClientInterface = client
+	if p.client != nil {
+		cli = p.client
+	}
+
+	var adminClient AdminClientInterface = NewAdminClient(apiKey)
+	if tokens != nil {
+		adminClient = NewAdminClientWithTokens(tokens)
+	}
+	if p.adminClient != nil {
+		adminClient = p.adminClient
+	}
+
+	// In read-only mode every mutating call fails before reaching Pinecone.
+	if readOnly {
+		cli = NewReadOnlyClient(cli)
+		adminClient = NewReadOnlyAdminClient(adminClient)
+	}
+
+	newClient := func(otherAPIKey string, environment string) (PineconeClientInterface, error) {
+		var otherTokens *TokenSource
+		if otherAPIKey == "" {
+			otherAPIKey, otherTokens = apiKey, tokens
+		}
+		var other PineconeClientInterface
+		var err error
+		if p.newClient != nil {
+			other, err = p.newClient(otherAPIKey, otherTokens, environment)
+		} else {
+			other, err = newClientWithCredentials(otherAPIKey, otherTokens, environment)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if readOnly {
+			other = NewReadOnlyClient(other)
+		}
+		return other, nil
+	}
+
 	// Make the Pinecone API client available to data sources and resources.
+	data := &pineconeProviderData{
+		client:                 cli,
+		adminClient:            adminClient,
+		policy:                 policy,
+		pricing:                pricing,
+		costIncreaseWarningUSD: costIncreaseWarningUSD,
+		newClient:              newClient,
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
 	if p.client != nil {
-		resp.DataSourceData = p.client
-		resp.ResourceData = p.client
 		tflog.Info(ctx, "Configured Mock Pinecone client", map[string]any{"success": true})
 		return
 	}
-
-	resp.DataSourceData = client
-	resp.ResourceData = client
 	tflog.Info(ctx, "Configured Pinecone client", map[string]any{"success": true})
 }
 
+// newClientWithCredentials creates a client authenticated with OAuth tokens if set, else with the API key.
+func newClientWithCredentials(apiKey string, tokens *TokenSource, environment string) (PineconeClientInterface, error) {
+	if tokens != nil {
+		return NewClientWithTokens(tokens, environment)
+	}
+	return NewClient(apiKey, environment)
+}
+
+// newIndexPolicy builds the index guardrails from the provider configuration.
+func newIndexPolicy(ctx context.Context, config pineconeProviderModel, diags *diag.Diagnostics) *IndexPolicy {
+	policy := &IndexPolicy{}
+
+	if config.AllowedPodTypes.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("allowed_pod_types"),
+			"Unknown pinecone allowed_pod_types",
+			"The provider cannot enforce allowed_pod_types as its value is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return nil
+	}
+
+	var podTypes []string
+	diags.Append(config.AllowedPodTypes.ElementsAs(ctx, &podTypes, false)...)
+	for i, v := range podTypes {
+		podType, err := NewPodType(v)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("allowed_pod_types").AtListIndex(i),
+				"Invalid pinecone allowed_pod_types",
+				"allowed_pod_types must only contain valid pod types: "+err.Error(),
+			)
+			continue
+		}
+		policy.AllowedPodTypes = append(policy.AllowedPodTypes, podType)
+	}
+
+	limits := []struct {
+		name  string
+		value types.Int64
+		dst   *int
+	}{
+		{name: "max_pods_per_index", value: config.MaxPodsPerIndex, dst: &policy.MaxPodsPerIndex},
+		{name: "max_replicas", value: config.MaxReplicas, dst: &policy.MaxReplicas},
+		{name: "max_total_pods", value: config.MaxTotalPods, dst: &policy.MaxTotalPods},
+	}
+	for _, limit := range limits {
+		if limit.value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root(limit.name),
+				"Unknown pinecone "+limit.name,
+				"The provider cannot enforce "+limit.name+" as its value is unknown. "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+			continue
+		}
+		if limit.value.IsNull() {
+			continue
+		}
+		if limit.value.ValueInt64() < 1 {
+			diags.AddAttributeError(
+				path.Root(limit.name),
+				"Invalid pinecone "+limit.name,
+				limit.name+" must be at least 1.",
+			)
+			continue
+		}
+		*limit.dst = int(limit.value.ValueInt64())
+	}
+
+	return policy
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *pineconeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewIndexDataSource,
+		NewCapacityPlanDataSource,
+		NewIndexStatsDataSource,
+		NewQueryDataSource,
+		NewIndexHealthDataSource,
+		NewBackupsDataSource,
+		NewRestoreJobsDataSource,
+		NewEmbeddingModelsDataSource,
+		NewEmbedDataSource,
 	}
 }
 
@@ -176,5 +492,33 @@ func (p *pineconeProvider) DataSources(_ context.Context) []func() datasource.Da
 func (p *pineconeProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIndexResource,
+		NewVectorsResource,
+		NewNamespaceResource,
+		NewIndexExportResource,
+		NewIndexCopyResource,
+		NewProjectResource,
+		NewAPIKeyResource,
+		NewBackupResource,
+		NewRestoreJobResource,
+		NewAssistantResource,
+		NewAssistantFileResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *pineconeProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPIKeyEphemeralResource,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider.
+func (p *pineconeProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParsePodTypeFunction,
+		NewScalePodTypeFunction,
+		NewValidateMetricFunction,
+		NewIndexNameFunction,
+		NewMetadataFilterFunction,
 	}
 }
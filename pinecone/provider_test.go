@@ -1,6 +1,11 @@
 package pinecone
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -39,3 +44,51 @@ var (
 			}()),
 	}
 )
+
+// testAccProtoV6ProviderFactoriesWithClient is like testAccProtoV6ProviderFactories
+// but serves the given client, so tests can seed it with remote state.
+func testAccProtoV6ProviderFactoriesWithClient(cli PineconeClientInterface) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"pinecone": providerserver.NewProtocol6WithError(New(cli)),
+	}
+}
+
+// testAccProtoV6ProviderFactoriesWithClients is like testAccProtoV6ProviderFactoriesWithClient
+// but also serves clients for other credentials, such as the source of pinecone_index_copy.
+// Clients for OAuth credentials are keyed by "oauth:" and the client ID.
+func testAccProtoV6ProviderFactoriesWithClients(cli PineconeClientInterface, others map[string]PineconeClientInterface) map[string]func() (tfprotov6.ProviderServer, error) {
+	newClient := func(apiKey string, tokens *TokenSource, environment string) (PineconeClientInterface, error) {
+		if tokens != nil {
+			apiKey = "oauth:" + tokens.ClientID
+		}
+		other, ok := others[apiKey]
+		if !ok {
+			return nil, fmt.Errorf("error: invalid API key for environment %s", environment)
+		}
+		return other, nil
+	}
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"pinecone": providerserver.NewProtocol6WithError(&pineconeProvider{client: cli, newClient: newClient}),
+	}
+}
+
+// testAccProtoV6ProviderFactoriesWithAdminClient is like testAccProtoV6ProviderFactoriesWithClient
+// but also serves the given admin API client.
+func testAccProtoV6ProviderFactoriesWithAdminClient(cli PineconeClientInterface, admin AdminClientInterface) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"pinecone": providerserver.NewProtocol6WithError(&pineconeProvider{client: cli, adminClient: admin}),
+	}
+}
+
+// runFunction calls a provider function directly, so functions can be tested
+// without a Terraform CLI that supports them.
+func runFunction(f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(result),
+	}
+	f.Run(context.Background(), req, resp)
+	return resp.Result.Value(), resp.Error
+}
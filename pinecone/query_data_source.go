@@ -0,0 +1,385 @@
+package pinecone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	defaultQueryTopK = 10
+	maxQueryTopK     = 10000
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &queryDataSource{}
+	_ datasource.DataSourceWithConfigure      = &queryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &queryDataSource{}
+)
+
+// NewQueryDataSource is a helper function to simplify the provider implementation.
+func NewQueryDataSource() datasource.DataSource {
+	return &queryDataSource{}
+}
+
+// queryDataSource is the data source implementation.
+type queryDataSource struct {
+	client PineconeClientInterface
+}
+
+type queryDataSourceModel struct {
+	ID            types.String       `tfsdk:"id"`
+	IndexName     types.String       `tfsdk:"index_name"`
+	Namespace     types.String       `tfsdk:"namespace"`
+	Vector        []types.Float64    `tfsdk:"vector"`
+	SparseVector  *sparseValuesModel `tfsdk:"sparse_vector"`
+	VectorID      types.String       `tfsdk:"vector_id"`
+	TopK          types.Int64        `tfsdk:"top_k"`
+	Filter        types.String       `tfsdk:"filter"`
+	IncludeValues types.Bool         `tfsdk:"include_values"`
+	Matches       types.List         `tfsdk:"matches"`
+}
+
+type sparseValuesModel struct {
+	Indices []types.Int64   `tfsdk:"indices"`
+	Values  []types.Float64 `tfsdk:"values"`
+}
+
+// sparseValues converts the model to its API form.
+func (m *sparseValuesModel) sparseValues() (*SparseValues, error) {
+	sparse := &SparseValues{}
+	for _, i := range m.Indices {
+		if v := i.ValueInt64(); v < 0 || v > math.MaxUint32 {
+			return nil, fmt.Errorf("error: sparse index %d is out of range", v)
+		}
+		sparse.Indices = append(sparse.Indices, uint32(i.ValueInt64()))
+	}
+	for _, v := range m.Values {
+		sparse.Values = append(sparse.Values, float32(v.ValueFloat64()))
+	}
+	return sparse, sparse.Validate()
+}
+
+var sparseValuesAttributeTypes = map[string]attr.Type{
+	"indices": types.ListType{ElemType: types.Int64Type},
+	"values":  types.ListType{ElemType: types.Float64Type},
+}
+
+var queryMatchAttributeTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"score":         types.Float64Type,
+	"values":        types.ListType{ElemType: types.Float64Type},
+	"sparse_values": types.ObjectType{AttrTypes: sparseValuesAttributeTypes},
+	"metadata":      types.StringType,
+}
+
+// Metadata returns the data source type name.
+func (d *queryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query"
+}
+
+// Schema defines the schema for the data source.
+func (d *queryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query an index for the vectors most similar to a vector or to a stored vector, e.g. to assert on retrieval quality in postconditions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the query.",
+				Computed:    true,
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index.",
+				Required:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace to query. Defaults to the default namespace \"\".",
+				Optional:    true,
+			},
+			"vector": schema.ListAttribute{
+				Description: "The values of the query vector. Exactly one of vector and vector_id must be set.",
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
+			"sparse_vector": schema.SingleNestedAttribute{
+				Description: "The sparse part of a hybrid query, added to vector. The index must use the dotproduct metric.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"indices": schema.ListAttribute{
+						Description: "The positions of the non-zero values.",
+						Required:    true,
+						ElementType: types.Int64Type,
+					},
+					"values": schema.ListAttribute{
+						Description: "The non-zero values, one per index.",
+						Required:    true,
+						ElementType: types.Float64Type,
+					},
+				},
+			},
+			"vector_id": schema.StringAttribute{
+				Description: "The ID of a stored vector to query with. Exactly one of vector and vector_id must be set.",
+				Optional:    true,
+			},
+			"top_k": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of matches to return, from 1 to %d. Defaults to %d.", maxQueryTopK, defaultQueryTopK),
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "A JSON metadata filter, e.g. jsonencode({ genre = { \"$eq\" = \"drama\" } }) or provider::pinecone::metadata_filter({ ... }). Only matching vectors are returned.",
+				Optional:    true,
+			},
+			"include_values": schema.BoolAttribute{
+				Description: "Whether to return the values of the matches.",
+				Optional:    true,
+			},
+			"matches": schema.ListNestedAttribute{
+				Description: "The matches, most similar first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the vector.",
+							Computed:    true,
+						},
+						"score": schema.Float64Attribute{
+							Description: "The similarity score of the vector. For euclidean, a lower score is more similar.",
+							Computed:    true,
+						},
+						"values": schema.ListAttribute{
+							Description: "The values of the vector, if include_values is true.",
+							Computed:    true,
+							ElementType: types.Float64Type,
+						},
+						"sparse_values": schema.SingleNestedAttribute{
+							Description: "The sparse values of the vector, if include_values is true and the vector has any.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"indices": schema.ListAttribute{
+									Description: "The positions of the non-zero values.",
+									Computed:    true,
+									ElementType: types.Int64Type,
+								},
+								"values": schema.ListAttribute{
+									Description: "The non-zero values, one per index.",
+									Computed:    true,
+									ElementType: types.Float64Type,
+								},
+							},
+						},
+						"metadata": schema.StringAttribute{
+							Description: "The metadata of the vector as JSON; use jsondecode to read it.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that exactly one query vector is given, the sparse vector is
+// well-formed and top_k is in range.
+func (d *queryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config struct {
+		Vector       types.List   `tfsdk:"vector"`
+		SparseVector types.Object `tfsdk:"sparse_vector"`
+		VectorID     types.String `tfsdk:"vector_id"`
+		TopK         types.Int64  `tfsdk:"top_k"`
+		Filter       types.String `tfsdk:"filter"`
+	}
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vector"), &config.Vector)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sparse_vector"), &config.SparseVector)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vector_id"), &config.VectorID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("top_k"), &config.TopK)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter"), &config.Filter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Vector.IsUnknown() || config.VectorID.IsUnknown() {
+		return
+	}
+	if config.Vector.IsNull() == config.VectorID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("vector"), "Invalid query", "Exactly one of vector and vector_id must be set.")
+	}
+	if !config.TopK.IsNull() && !config.TopK.IsUnknown() {
+		if v := config.TopK.ValueInt64(); v < 1 || v > maxQueryTopK {
+			resp.Diagnostics.AddAttributeError(path.Root("top_k"), "Invalid top_k",
+				fmt.Sprintf("top_k must be between 1 and %d, got %d.", maxQueryTopK, v))
+		}
+	}
+	if _, err := decodeFilter(config.Filter); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid filter", err.Error())
+	}
+	if config.SparseVector.IsNull() || config.SparseVector.IsUnknown() {
+		return
+	}
+	if !config.VectorID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("sparse_vector"), "Invalid query", "sparse_vector can only be used with vector.")
+	}
+	var sparse sparseValuesModel
+	diags := config.SparseVector.As(ctx, &sparse, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		// Unknown indices or values are checked at read time.
+		return
+	}
+	if _, err := sparse.sparseValues(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sparse_vector"), "Invalid sparse_vector", err.Error())
+	}
+}
+
+// newTFQueryMatch converts a match to its Terraform object.
+func newTFQueryMatch(match ScoredVector) (attr.Value, error) {
+	values := types.ListNull(types.Float64Type)
+	if match.Values != nil {
+		elements := make([]attr.Value, len(match.Values))
+		for i, v := range match.Values {
+			elements[i] = types.Float64Value(float64(v))
+		}
+		values = types.ListValueMust(types.Float64Type, elements)
+	}
+
+	sparseValues := types.ObjectNull(sparseValuesAttributeTypes)
+	if match.SparseValues != nil {
+		indices := make([]attr.Value, len(match.SparseValues.Indices))
+		for i, v := range match.SparseValues.Indices {
+			indices[i] = types.Int64Value(int64(v))
+		}
+		elements := make([]attr.Value, len(match.SparseValues.Values))
+		for i, v := range match.SparseValues.Values {
+			elements[i] = types.Float64Value(float64(v))
+		}
+		sparseValues = types.ObjectValueMust(sparseValuesAttributeTypes, map[string]attr.Value{
+			"indices": types.ListValueMust(types.Int64Type, indices),
+			"values":  types.ListValueMust(types.Float64Type, elements),
+		})
+	}
+
+	metadata := types.StringNull()
+	if match.Metadata != nil {
+		data, err := json.Marshal(match.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		metadata = types.StringValue(string(data))
+	}
+
+	object, diags := types.ObjectValue(queryMatchAttributeTypes, map[string]attr.Value{
+		"id":            types.StringValue(match.ID),
+		"score":         types.Float64Value(float64(match.Score)),
+		"values":        values,
+		"sparse_values": sparseValues,
+		"metadata":      metadata,
+	})
+	if diags.HasError() {
+		return nil, fmt.Errorf("error: invalid match %s", match.ID)
+	}
+	return object, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *queryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data queryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := decodeFilter(data.Filter)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid filter", err.Error())
+		return
+	}
+
+	queryReq := QueryRequest{
+		Namespace:       data.Namespace.ValueString(),
+		TopK:            defaultQueryTopK,
+		Filter:          filter,
+		IncludeValues:   data.IncludeValues.ValueBool(),
+		IncludeMetadata: true,
+		ID:              data.VectorID.ValueString(),
+	}
+	if !data.TopK.IsNull() {
+		queryReq.TopK = int(data.TopK.ValueInt64())
+	}
+	for _, v := range data.Vector {
+		queryReq.Vector = append(queryReq.Vector, float32(v.ValueFloat64()))
+	}
+	if data.SparseVector != nil {
+		queryReq.SparseVector, err = data.SparseVector.sparseValues()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("sparse_vector"), "Invalid sparse_vector", err.Error())
+			return
+		}
+
+		index, err := d.client.DescribeIndex(ctx, data.IndexName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error DescribeIndex", err.Error())
+			return
+		}
+		if index == nil {
+			resp.Diagnostics.AddError("Error Query", fmt.Sprintf("error: index %s not found", data.IndexName.ValueString()))
+			return
+		}
+		if err := checkSparseMetric(index.Database.Name, index.Database.Metric); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("sparse_vector"), "Invalid sparse_vector", err.Error())
+			return
+		}
+	}
+
+	result, err := d.client.Query(ctx, data.IndexName.ValueString(), queryReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Query", err.Error())
+		return
+	}
+
+	matches := make([]attr.Value, 0, len(result.Matches))
+	for _, match := range result.Matches {
+		object, err := newTFQueryMatch(match)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Query", err.Error())
+			return
+		}
+		matches = append(matches, object)
+	}
+	matchesValue, diags := types.ListValue(types.ObjectType{AttrTypes: queryMatchAttributeTypes}, matches)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.IndexName.ValueString())
+	data.Matches = matchesValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *queryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+
+	d.client = data.client
+}
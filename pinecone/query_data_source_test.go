@@ -0,0 +1,158 @@
+package pinecone
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueryDataSource(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2, Metric: MetricDotProduct})
+	_, _ = cli.Upsert(ctx, "test", UpsertRequest{Namespace: "docs", Vectors: []Vector{
+		{ID: "a", Values: []float32{1, 0}, Metadata: map[string]any{"genre": "drama"}},
+		{ID: "b", Values: []float32{0, 1}, Metadata: map[string]any{"genre": "comedy"}},
+		{ID: "c", Values: []float32{0.5, 0.5}},
+	}})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_query" "by_vector" {
+    index_name = "test"
+    namespace  = "docs"
+    vector     = [1, 0.25]
+    top_k      = 2
+}
+
+data "pinecone_query" "by_id" {
+    index_name     = "test"
+    namespace      = "docs"
+    vector_id      = "a"
+    filter         = jsonencode({ genre = { "$in" = ["comedy", "drama"] } })
+    include_values = true
+
+    lifecycle {
+        postcondition {
+            condition     = jsondecode(self.matches[0].metadata).genre == "drama"
+            error_message = "The closest match must be a drama."
+        }
+    }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_query.by_vector", "id", "test"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_vector", "matches.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_vector", "matches.0.id", "a"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_vector", "matches.0.score", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_vector", "matches.0.metadata", `{"genre":"drama"}`),
+					resource.TestCheckNoResourceAttr("data.pinecone_query.by_vector", "matches.0.values.#"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_vector", "matches.1.id", "c"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_vector", "matches.1.score", "0.625"),
+					resource.TestCheckNoResourceAttr("data.pinecone_query.by_vector", "matches.1.metadata"),
+
+					resource.TestCheckResourceAttr("data.pinecone_query.by_id", "matches.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_id", "matches.0.id", "a"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_id", "matches.0.values.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_query.by_id", "matches.1.id", "b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQueryDataSourceSparseVector(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "cosine", Dimension: 2, Metric: MetricCosine})
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "hybrid", Dimension: 2, Metric: MetricDotProduct})
+	_, _ = cli.Upsert(ctx, "hybrid", UpsertRequest{Vectors: []Vector{
+		{ID: "a", Values: []float32{1, 0}},
+		{ID: "b", Values: []float32{0.5, 0}, SparseValues: &SparseValues{Indices: []uint32{3}, Values: []float32{1}}},
+	}})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_query" "test" {
+    index_name    = "cosine"
+    vector        = [1, 0]
+    sparse_vector = { indices = [3], values = [1] }
+}
+`,
+				ExpectError: regexp.MustCompile(`index\s+cosine\s+has\s+metric\s+cosine,\s+but\s+sparse\s+values\s+require\s+dotproduct`),
+			},
+			{
+				Config: providerConfig + `
+data "pinecone_query" "test" {
+    index_name     = "hybrid"
+    vector         = [1, 0]
+    sparse_vector  = { indices = [3], values = [1] }
+    include_values = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_query.test", "matches.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_query.test", "matches.0.id", "b"),
+					resource.TestCheckResourceAttr("data.pinecone_query.test", "matches.0.score", "1.5"),
+					resource.TestCheckResourceAttr("data.pinecone_query.test", "matches.0.sparse_values.indices.0", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_query.test", "matches.0.sparse_values.values.0", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_query.test", "matches.1.id", "a"),
+					resource.TestCheckNoResourceAttr("data.pinecone_query.test", "matches.1.sparse_values.indices.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQueryDataSourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_query" "test" {
+    index_name = "test"
+    vector     = [1, 0]
+    vector_id  = "a"
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly\s+one\s+of\s+vector\s+and\s+vector_id\s+must\s+be\s+set`),
+			},
+			{
+				Config: providerConfig + `
+data "pinecone_query" "test" {
+    index_name = "test"
+    vector_id  = "a"
+    top_k      = 0
+    filter     = "genre = drama"
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)top_k\s+must\s+be\s+between\s+1\s+and\s+10000.*invalid\s+filter\s+at\s+filter:\s+must\s+be\s+a\s+JSON\s+object`),
+			},
+			{
+				Config: providerConfig + `
+data "pinecone_query" "test" {
+    index_name    = "test"
+    vector        = [1, 0]
+    sparse_vector = { indices = [1, 1], values = [0.5, 0.5] }
+}
+`,
+				ExpectError: regexp.MustCompile(`sparse\s+values\s+have\s+duplicate\s+index\s+1`),
+			},
+		},
+	})
+}
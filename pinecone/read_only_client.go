@@ -0,0 +1,220 @@
+package pinecone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrReadOnly = errors.New("error: provider is in read-only mode")
+
+var (
+	_ PineconeClientInterface = &ReadOnlyClient{}
+)
+
+// ReadOnlyClient wraps a PineconeClientInterface and rejects every mutating call.
+// It deliberately does not embed the wrapped client, so adding a method to
+// PineconeClientInterface fails to compile until it is classified here.
+type ReadOnlyClient struct {
+	client PineconeClientInterface
+}
+
+// NewReadOnlyClient returns a client that passes read calls through to client
+// and returns ErrReadOnly for anything that would change remote state.
+func NewReadOnlyClient(client PineconeClientInterface) *ReadOnlyClient {
+	return &ReadOnlyClient{
+		client: client,
+	}
+}
+
+func readOnlyError(operation string, target string) error {
+	return fmt.Errorf("%w: refusing to %s %q because read_only is enabled (unset read_only or PINECONE_READ_ONLY to allow changes)", ErrReadOnly, operation, target)
+}
+
+func (c *ReadOnlyClient) GetAPIKey() string {
+	return c.client.GetAPIKey()
+}
+
+func (c *ReadOnlyClient) GetEnvironment() string {
+	return c.client.GetEnvironment()
+}
+
+func (c *ReadOnlyClient) GetBaseURL() string {
+	return c.client.GetBaseURL()
+}
+
+func (c *ReadOnlyClient) ListIndexes(ctx context.Context) ([]string, error) {
+	return c.client.ListIndexes(ctx)
+}
+
+func (c *ReadOnlyClient) DescribeIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error) {
+	return c.client.DescribeIndex(ctx, indexName)
+}
+
+func (c *ReadOnlyClient) DescribeServerlessIndex(ctx context.Context, indexName string) (*DescribeIndexResponse, error) {
+	return c.client.DescribeServerlessIndex(ctx, indexName)
+}
+
+func (c *ReadOnlyClient) DescribeIndexStats(ctx context.Context, indexName string, req DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error) {
+	return c.client.DescribeIndexStats(ctx, indexName, req)
+}
+
+func (c *ReadOnlyClient) Fetch(ctx context.Context, indexName string, req FetchRequest) (*FetchResponse, error) {
+	return c.client.Fetch(ctx, indexName, req)
+}
+
+func (c *ReadOnlyClient) Query(ctx context.Context, indexName string, req QueryRequest) (*QueryResponse, error) {
+	return c.client.Query(ctx, indexName, req)
+}
+
+func (c *ReadOnlyClient) List(ctx context.Context, indexName string, req ListRequest) (*ListResponse, error) {
+	return c.client.List(ctx, indexName, req)
+}
+
+func (c *ReadOnlyClient) ListBackups(ctx context.Context) ([]Backup, error) {
+	return c.client.ListBackups(ctx)
+}
+
+func (c *ReadOnlyClient) DescribeBackup(ctx context.Context, backupID string) (*Backup, error) {
+	return c.client.DescribeBackup(ctx, backupID)
+}
+
+func (c *ReadOnlyClient) ListRestoreJobs(ctx context.Context) ([]RestoreJob, error) {
+	return c.client.ListRestoreJobs(ctx)
+}
+
+func (c *ReadOnlyClient) DescribeRestoreJob(ctx context.Context, jobID string) (*RestoreJob, error) {
+	return c.client.DescribeRestoreJob(ctx, jobID)
+}
+
+// ListModels only lists the hosted models.
+func (c *ReadOnlyClient) ListModels(ctx context.Context) ([]ModelInfo, error) {
+	return c.client.ListModels(ctx)
+}
+
+// Embed changes no remote state, so it is allowed in read-only mode.
+func (c *ReadOnlyClient) Embed(ctx context.Context, req EmbedRequest) (*EmbedResponse, error) {
+	return c.client.Embed(ctx, req)
+}
+
+func (c *ReadOnlyClient) DescribeAssistant(ctx context.Context, name string) (*Assistant, error) {
+	return c.client.DescribeAssistant(ctx, name)
+}
+
+func (c *ReadOnlyClient) DescribeAssistantFile(ctx context.Context, assistantName string, fileID string) (*AssistantFile, error) {
+	return c.client.DescribeAssistantFile(ctx, assistantName, fileID)
+}
+
+func (c *ReadOnlyClient) CreateIndex(ctx context.Context, req CreateIndexRequest) error {
+	return readOnlyError("create index", req.Name)
+}
+
+func (c *ReadOnlyClient) CreateIndexForModel(ctx context.Context, req CreateIndexForModelRequest) error {
+	return readOnlyError("create index", req.Name)
+}
+
+func (c *ReadOnlyClient) DeleteIndex(ctx context.Context, indexName string) error {
+	return readOnlyError("delete index", indexName)
+}
+
+func (c *ReadOnlyClient) ConfigureIndex(ctx context.Context, indexName string, req ConfigureIndexRequest) error {
+	return readOnlyError("configure index", indexName)
+}
+
+func (c *ReadOnlyClient) CreateBackup(ctx context.Context, indexName string, req CreateBackupRequest) (*Backup, error) {
+	return nil, readOnlyError("back up index", indexName)
+}
+
+func (c *ReadOnlyClient) DeleteBackup(ctx context.Context, backupID string) error {
+	return readOnlyError("delete backup", backupID)
+}
+
+func (c *ReadOnlyClient) CreateIndexFromBackup(ctx context.Context, backupID string, req CreateIndexFromBackupRequest) (*CreateIndexFromBackupResponse, error) {
+	return nil, readOnlyError("restore backup into index", req.Name)
+}
+
+func (c *ReadOnlyClient) CreateAssistant(ctx context.Context, req CreateAssistantRequest) (*Assistant, error) {
+	return nil, readOnlyError("create assistant", req.Name)
+}
+
+func (c *ReadOnlyClient) UpdateAssistant(ctx context.Context, name string, req UpdateAssistantRequest) (*Assistant, error) {
+	return nil, readOnlyError("update assistant", name)
+}
+
+func (c *ReadOnlyClient) DeleteAssistant(ctx context.Context, name string) error {
+	return readOnlyError("delete assistant", name)
+}
+
+func (c *ReadOnlyClient) UploadAssistantFile(ctx context.Context, assistantName string, fileName string, content io.Reader, metadata map[string]any) (*AssistantFile, error) {
+	return nil, readOnlyError("upload file to assistant", assistantName)
+}
+
+func (c *ReadOnlyClient) DeleteAssistantFile(ctx context.Context, assistantName string, fileID string) error {
+	return readOnlyError("delete file from assistant", assistantName)
+}
+
+func (c *ReadOnlyClient) Upsert(ctx context.Context, indexName string, req UpsertRequest) (*UpsertResponse, error) {
+	return nil, readOnlyError("upsert vectors into index", indexName)
+}
+
+func (c *ReadOnlyClient) Delete(ctx context.Context, indexName string, req DeleteRequest) error {
+	return readOnlyError("delete vectors from index", indexName)
+}
+
+var (
+	_ AdminClientInterface = &ReadOnlyAdminClient{}
+)
+
+// ReadOnlyAdminClient is the ReadOnlyClient of the admin API.
+type ReadOnlyAdminClient struct {
+	client AdminClientInterface
+}
+
+// NewReadOnlyAdminClient returns an admin client that passes read calls through
+// to client and returns ErrReadOnly for anything that would change remote state.
+func NewReadOnlyAdminClient(client AdminClientInterface) *ReadOnlyAdminClient {
+	return &ReadOnlyAdminClient{
+		client: client,
+	}
+}
+
+func (c *ReadOnlyAdminClient) ListProjects(ctx context.Context) ([]Project, error) {
+	return c.client.ListProjects(ctx)
+}
+
+func (c *ReadOnlyAdminClient) DescribeProject(ctx context.Context, projectID string) (*Project, error) {
+	return c.client.DescribeProject(ctx, projectID)
+}
+
+func (c *ReadOnlyAdminClient) CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error) {
+	return nil, readOnlyError("create project", req.Name)
+}
+
+func (c *ReadOnlyAdminClient) UpdateProject(ctx context.Context, projectID string, req UpdateProjectRequest) (*Project, error) {
+	return nil, readOnlyError("update project", projectID)
+}
+
+func (c *ReadOnlyAdminClient) DeleteProject(ctx context.Context, projectID string) error {
+	return readOnlyError("delete project", projectID)
+}
+
+func (c *ReadOnlyAdminClient) ListAPIKeys(ctx context.Context, projectID string) ([]APIKey, error) {
+	return c.client.ListAPIKeys(ctx, projectID)
+}
+
+func (c *ReadOnlyAdminClient) DescribeAPIKey(ctx context.Context, apiKeyID string) (*APIKey, error) {
+	return c.client.DescribeAPIKey(ctx, apiKeyID)
+}
+
+func (c *ReadOnlyAdminClient) CreateAPIKey(ctx context.Context, projectID string, req CreateAPIKeyRequest) (*APIKeyWithSecret, error) {
+	return nil, readOnlyError("create API key", req.Name)
+}
+
+func (c *ReadOnlyAdminClient) UpdateAPIKey(ctx context.Context, apiKeyID string, req UpdateAPIKeyRequest) (*APIKey, error) {
+	return nil, readOnlyError("update API key", apiKeyID)
+}
+
+func (c *ReadOnlyAdminClient) DeleteAPIKey(ctx context.Context, apiKeyID string) error {
+	return readOnlyError("delete API key", apiKeyID)
+}
@@ -0,0 +1,254 @@
+package pinecone
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestReadOnlyClient(t *testing.T) {
+	ctx := context.Background()
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.CreateIndex(ctx, CreateIndexRequest{Name: "existing"}); err != nil {
+		t.Fatal(err)
+	}
+
+	cli := NewReadOnlyClient(mock)
+
+	if cli.GetAPIKey() != "test_api_key" || cli.GetEnvironment() != "test" {
+		t.Fatalf("expected getters to pass through, got %q %q", cli.GetAPIKey(), cli.GetEnvironment())
+	}
+
+	indexes, err := cli.ListIndexes(ctx)
+	if err != nil || len(indexes) != 1 {
+		t.Fatalf("expected ListIndexes to pass through, got %v, %v", indexes, err)
+	}
+
+	index, err := cli.DescribeIndex(ctx, "existing")
+	if err != nil || index == nil {
+		t.Fatalf("expected DescribeIndex to pass through, got %v, %v", index, err)
+	}
+
+	serverless, err := cli.DescribeServerlessIndex(ctx, "existing")
+	if err != nil || serverless == nil {
+		t.Fatalf("expected DescribeServerlessIndex to pass through, got %v, %v", serverless, err)
+	}
+
+	stats, err := cli.DescribeIndexStats(ctx, "existing", DescribeIndexStatsRequest{})
+	if err != nil || stats == nil {
+		t.Fatalf("expected DescribeIndexStats to pass through, got %v, %v", stats, err)
+	}
+
+	backup, err := mock.CreateBackup(ctx, "existing", CreateBackupRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	backups, err := cli.ListBackups(ctx)
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("expected ListBackups to pass through, got %v, %v", backups, err)
+	}
+	described, err := cli.DescribeBackup(ctx, backup.ID)
+	if err != nil || described == nil {
+		t.Fatalf("expected DescribeBackup to pass through, got %v, %v", described, err)
+	}
+	jobs, err := cli.ListRestoreJobs(ctx)
+	if err != nil || len(jobs) != 0 {
+		t.Fatalf("expected ListRestoreJobs to pass through, got %v, %v", jobs, err)
+	}
+	job, err := cli.DescribeRestoreJob(ctx, "missing")
+	if err != nil || job != nil {
+		t.Fatalf("expected DescribeRestoreJob to pass through, got %v, %v", job, err)
+	}
+	embedded, err := cli.Embed(ctx, EmbedRequest{Model: "multilingual-e5-large", Inputs: []EmbedInput{{Text: "hello"}}})
+	if err != nil || len(embedded.Data) != 1 {
+		t.Fatalf("expected Embed to pass through, got %v, %v", embedded, err)
+	}
+	models, err := cli.ListModels(ctx)
+	if err != nil || len(models) == 0 {
+		t.Fatalf("expected ListModels to pass through, got %v, %v", models, err)
+	}
+	assistant, err := mock.CreateAssistant(ctx, CreateAssistantRequest{Name: "docs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := mock.UploadAssistantFile(ctx, "docs", "guide.md", strings.NewReader("# Guide"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	describedAssistant, err := cli.DescribeAssistant(ctx, assistant.Name)
+	if err != nil || describedAssistant == nil {
+		t.Fatalf("expected DescribeAssistant to pass through, got %v, %v", describedAssistant, err)
+	}
+	describedFile, err := cli.DescribeAssistantFile(ctx, assistant.Name, file.ID)
+	if err != nil || describedFile == nil {
+		t.Fatalf("expected DescribeAssistantFile to pass through, got %v, %v", describedFile, err)
+	}
+
+	testCases := []struct {
+		name string
+		call func() error
+	}{
+		{name: "CreateIndex", call: func() error { return cli.CreateIndex(ctx, CreateIndexRequest{Name: "new"}) }},
+		{name: "CreateIndexForModel", call: func() error {
+			return cli.CreateIndexForModel(ctx, CreateIndexForModelRequest{Name: "new", Embed: IndexForModelEmbed{IndexEmbed: IndexEmbed{Model: "llama-text-embed-v2"}}})
+		}},
+		{name: "ConfigureIndex", call: func() error {
+			replicas := 2
+			return cli.ConfigureIndex(ctx, "existing", ConfigureIndexRequest{Replicas: &replicas})
+		}},
+		{name: "DeleteIndex", call: func() error { return cli.DeleteIndex(ctx, "existing") }},
+		{name: "Upsert", call: func() error {
+			_, err := cli.Upsert(ctx, "existing", UpsertRequest{Vectors: []Vector{{ID: "a"}}})
+			return err
+		}},
+		{name: "Delete", call: func() error { return cli.Delete(ctx, "existing", DeleteRequest{DeleteAll: true}) }},
+		{name: "CreateBackup", call: func() error {
+			_, err := cli.CreateBackup(ctx, "existing", CreateBackupRequest{})
+			return err
+		}},
+		{name: "DeleteBackup", call: func() error { return cli.DeleteBackup(ctx, backup.ID) }},
+		{name: "CreateIndexFromBackup", call: func() error {
+			_, err := cli.CreateIndexFromBackup(ctx, backup.ID, CreateIndexFromBackupRequest{Name: "restored"})
+			return err
+		}},
+		{name: "CreateAssistant", call: func() error {
+			_, err := cli.CreateAssistant(ctx, CreateAssistantRequest{Name: "new"})
+			return err
+		}},
+		{name: "UpdateAssistant", call: func() error {
+			_, err := cli.UpdateAssistant(ctx, "docs", UpdateAssistantRequest{Instructions: "Be brief."})
+			return err
+		}},
+		{name: "DeleteAssistant", call: func() error { return cli.DeleteAssistant(ctx, "docs") }},
+		{name: "UploadAssistantFile", call: func() error {
+			_, err := cli.UploadAssistantFile(ctx, "docs", "faq.md", strings.NewReader("# FAQ"), nil)
+			return err
+		}},
+		{name: "DeleteAssistantFile", call: func() error { return cli.DeleteAssistantFile(ctx, "docs", file.ID) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.call()
+			if !errors.Is(err, ErrReadOnly) {
+				t.Fatalf("test '%s' failed: expected ErrReadOnly, but received %v", tc.name, err)
+			}
+		})
+	}
+
+	// Nothing may have reached the wrapped client.
+	indexes, _ = mock.ListIndexes(ctx)
+	if len(indexes) != 1 || indexes[0] != "existing" {
+		t.Fatalf("expected wrapped client to be unchanged, got %v", indexes)
+	}
+}
+
+func TestReadOnlyAdminClient(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockAdminClient()
+	existing, err := mock.CreateProject(ctx, CreateProjectRequest{Name: "existing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cli := NewReadOnlyAdminClient(mock)
+
+	projects, err := cli.ListProjects(ctx)
+	if err != nil || len(projects) != 1 {
+		t.Fatalf("expected ListProjects to pass through, got %v, %v", projects, err)
+	}
+	project, err := cli.DescribeProject(ctx, existing.ID)
+	if err != nil || project == nil {
+		t.Fatalf("expected DescribeProject to pass through, got %v, %v", project, err)
+	}
+	apiKeys, err := cli.ListAPIKeys(ctx, existing.ID)
+	if err != nil || len(apiKeys) != 0 {
+		t.Fatalf("expected ListAPIKeys to pass through, got %v, %v", apiKeys, err)
+	}
+
+	name := "renamed"
+	testCases := []struct {
+		name string
+		call func() error
+	}{
+		{name: "CreateProject", call: func() error {
+			_, err := cli.CreateProject(ctx, CreateProjectRequest{Name: "new"})
+			return err
+		}},
+		{name: "UpdateProject", call: func() error {
+			_, err := cli.UpdateProject(ctx, existing.ID, UpdateProjectRequest{Name: &name})
+			return err
+		}},
+		{name: "DeleteProject", call: func() error { return cli.DeleteProject(ctx, existing.ID) }},
+		{name: "CreateAPIKey", call: func() error {
+			_, err := cli.CreateAPIKey(ctx, existing.ID, CreateAPIKeyRequest{Name: "new"})
+			return err
+		}},
+		{name: "UpdateAPIKey", call: func() error {
+			_, err := cli.UpdateAPIKey(ctx, "key", UpdateAPIKeyRequest{Name: &name})
+			return err
+		}},
+		{name: "DeleteAPIKey", call: func() error { return cli.DeleteAPIKey(ctx, "key") }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.call()
+			if !errors.Is(err, ErrReadOnly) {
+				t.Fatalf("test '%s' failed: expected ErrReadOnly, but received %v", tc.name, err)
+			}
+		})
+	}
+
+	// Nothing may have reached the wrapped client.
+	projects, _ = mock.ListProjects(ctx)
+	if len(projects) != 1 || projects[0].Name != "existing" {
+		t.Fatalf("expected wrapped client to be unchanged, got %v", projects)
+	}
+}
+
+func TestAccReadOnlyProvider(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "pinecone" {
+    environment = "test"
+    api_key     = "test_api_key"
+    read_only   = true
+}
+
+data "pinecone_index" "test" {
+    name = "test"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_index.test", "id", "test"),
+				),
+			},
+			{
+				Config: `
+provider "pinecone" {
+    environment = "test"
+    api_key     = "test_api_key"
+    read_only   = true
+}
+
+resource "pinecone_index" "test" {
+    name      = "test"
+    dimension = 1536
+}
+`,
+				ExpectError: regexp.MustCompile(`provider is in read-only`),
+			},
+		},
+	})
+}
@@ -0,0 +1,283 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &restoreJobResource{}
+	_ resource.ResourceWithConfigure   = &restoreJobResource{}
+	_ resource.ResourceWithImportState = &restoreJobResource{}
+)
+
+// NewRestoreJobResource is a helper function to simplify the provider implementation.
+func NewRestoreJobResource() resource.Resource {
+	return &restoreJobResource{}
+}
+
+// restoreJobResource is the resource implementation.
+type restoreJobResource struct {
+	client PineconeClientInterface
+}
+
+type restoreJobResourceModel struct {
+	ID              types.String  `tfsdk:"id"`
+	BackupID        types.String  `tfsdk:"backup_id"`
+	IndexName       types.String  `tfsdk:"index_name"`
+	IndexID         types.String  `tfsdk:"index_id"`
+	Status          types.String  `tfsdk:"status"`
+	PercentComplete types.Float64 `tfsdk:"percent_complete"`
+	CreatedAt       types.String  `tfsdk:"created_at"`
+	CompletedAt     types.String  `tfsdk:"completed_at"`
+}
+
+// setRestoreJob sets the model from a restore job returned by Pinecone.
+func (m *restoreJobResourceModel) setRestoreJob(job *RestoreJob) {
+	m.ID = types.StringValue(job.ID)
+	m.BackupID = types.StringValue(job.BackupID)
+	m.IndexName = types.StringValue(job.TargetIndexName)
+	m.IndexID = types.StringValue(job.TargetIndexID)
+	m.Status = types.StringValue(job.Status)
+	m.PercentComplete = types.Float64Value(job.PercentComplete)
+	m.CreatedAt = types.StringValue(job.CreatedAt)
+	m.CompletedAt = types.StringValue(job.CompletedAt)
+}
+
+// Metadata returns the resource type name.
+func (r *restoreJobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *restoreJobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restore a backup into a new index. Terraform waits until the restore job completed and the index is ready. " +
+			"The restored index belongs to this resource: destroying it deletes the index, and the backup is restored " +
+			"again if the index is deleted outside of Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the restore job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Description: "The ID of the backup to restore.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index to create from the backup. It must not exist yet.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index_id": schema.StringAttribute{
+				Description: "The ID of the restored index.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the restore job, e.g. Completed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"percent_complete": schema.Float64Attribute{
+				Description: "How much of the backup has been restored, in percent.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the restore job was started.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Description: "When the restore job completed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create restores the backup and waits until the index is ready.
+func (r *restoreJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan restoreJobResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateIndexFromBackup(ctx, plan.BackupID.ValueString(), CreateIndexFromBackupRequest{
+		Name: plan.IndexName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring backup",
+			"Could not restore backup "+plan.BackupID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save the restore job before waiting, so that the restored index is not orphaned
+	// when waiting fails. Terraform then taints it, and replacing it deletes the index.
+	plan.setRestoreJob(&RestoreJob{
+		ID:              created.RestoreJobID,
+		BackupID:        plan.BackupID.ValueString(),
+		TargetIndexName: plan.IndexName.ValueString(),
+		TargetIndexID:   created.IndexID,
+	})
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := WaitForRestoreJob(ctx, r.client, created.RestoreJobID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring backup",
+			"Restore job "+created.RestoreJobID+" of backup "+plan.BackupID.ValueString()+" did not complete: "+err.Error(),
+		)
+		return
+	}
+	plan.setRestoreJob(job)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *restoreJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state restoreJobResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.client.DescribeRestoreJob(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Restore Job",
+			"Could not read Pinecone Restore Job ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// If the restore job or the index it restored is not found, remove it from the state
+	if job == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	index, err := r.client.DescribeIndex(ctx, job.TargetIndexName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Restore Job",
+			"Could not read Pinecone Index "+job.TargetIndexName+": "+err.Error(),
+		)
+		return
+	}
+	if index == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.setRestoreJob(job)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called with changes, as every argument replaces the restore job.
+func (r *restoreJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan restoreJobResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the restored index and waits until it is gone. Restore jobs themselves cannot be deleted.
+func (r *restoreJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state restoreJobResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	indexName := state.IndexName.ValueString()
+	index, err := r.client.DescribeIndex(ctx, indexName)
+	if err == nil && index != nil {
+		err = r.client.DeleteIndex(ctx, indexName)
+		if err == nil {
+			err = WaitForIndexDeleted(ctx, r.client, indexName)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting restored index",
+			"Could not delete index "+indexName+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *restoreJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+}
+
+func (r *restoreJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,154 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRestoreJobResource(t *testing.T) {
+	cli := newMockClientWithMovies(t)
+
+	config := providerConfig + `
+resource "pinecone_backup" "test" {
+    source_index_name = "movies"
+}
+
+resource "pinecone_restore_job" "test" {
+    backup_id  = pinecone_backup.test.id
+    index_name = "movies-restored"
+}
+
+data "pinecone_index_stats" "restored" {
+    index_name = pinecone_restore_job.test.index_name
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		CheckDestroy: func(s *terraform.State) error {
+			index, err := cli.DescribeIndex(context.Background(), "movies-restored")
+			if err != nil {
+				return err
+			}
+			if index != nil {
+				return fmt.Errorf("expected the restored index to be deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "id", "mock-restore-job-2"),
+					resource.TestCheckResourceAttrPair("pinecone_restore_job.test", "backup_id", "pinecone_backup.test", "id"),
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "index_name", "movies-restored"),
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "index_id", "movies-restored-mock"),
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "status", "Completed"),
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "percent_complete", "100"),
+					resource.TestCheckResourceAttrSet("pinecone_restore_job.test", "created_at"),
+					resource.TestCheckResourceAttrSet("pinecone_restore_job.test", "completed_at"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.restored", "total_vector_count", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.restored", "namespaces.drama", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pinecone_restore_job.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A restored index deleted outside Terraform is restored again
+			{
+				PreConfig: func() {
+					_ = cli.DeleteIndex(context.Background(), "movies-restored")
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "id", "mock-restore-job-3"),
+					resource.TestCheckResourceAttr("data.pinecone_index_stats.restored", "total_vector_count", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRestoreJobResourceExistingIndex(t *testing.T) {
+	cli := newMockClientWithMovies(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pinecone_backup" "test" {
+    source_index_name = "movies"
+}
+
+resource "pinecone_restore_job" "test" {
+    backup_id  = pinecone_backup.test.id
+    index_name = "movies"
+}
+`,
+				ExpectError: regexp.MustCompile(`index\s+movies\s+already\s+exists`),
+			},
+		},
+	})
+}
+
+// failedRestoreJobClient reports restore jobs as failed while failed is set.
+type failedRestoreJobClient struct {
+	*MockPineconeClient
+	failed bool
+}
+
+func (c *failedRestoreJobClient) DescribeRestoreJob(ctx context.Context, jobID string) (*RestoreJob, error) {
+	job, err := c.MockPineconeClient.DescribeRestoreJob(ctx, jobID)
+	if job != nil && c.failed {
+		job.Status = RestoreJobStatusFailed
+	}
+	return job, err
+}
+
+func TestAccRestoreJobResourceFailed(t *testing.T) {
+	cli := &failedRestoreJobClient{MockPineconeClient: newMockClientWithMovies(t), failed: true}
+	backup, err := cli.CreateBackup(context.Background(), "movies", CreateBackupRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := providerConfig + fmt.Sprintf(`
+resource "pinecone_restore_job" "test" {
+    backup_id  = %q
+    index_name = "movies-restored"
+}
+`, backup.ID)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// A restore job that fails is kept in the state with its index
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Restore\s+job\s+mock-restore-job-2\s+of\s+backup\s+mock-backup-1\s+did\s+not\s+complete`),
+			},
+			// and replaced by the next apply, which deletes the index first
+			{
+				PreConfig: func() {
+					cli.failed = false
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "id", "mock-restore-job-3"),
+					resource.TestCheckResourceAttr("pinecone_restore_job.test", "status", "Completed"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &restoreJobsDataSource{}
+	_ datasource.DataSourceWithConfigure = &restoreJobsDataSource{}
+)
+
+// NewRestoreJobsDataSource is a helper function to simplify the provider implementation.
+func NewRestoreJobsDataSource() datasource.DataSource {
+	return &restoreJobsDataSource{}
+}
+
+// restoreJobsDataSource is the data source implementation.
+type restoreJobsDataSource struct {
+	client PineconeClientInterface
+}
+
+type restoreJobsDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	BackupID    types.String `tfsdk:"backup_id"`
+	RestoreJobs types.List   `tfsdk:"restore_jobs"`
+}
+
+var restoreJobAttributeTypes = map[string]attr.Type{
+	"id":               types.StringType,
+	"backup_id":        types.StringType,
+	"index_name":       types.StringType,
+	"index_id":         types.StringType,
+	"status":           types.StringType,
+	"percent_complete": types.Float64Type,
+	"created_at":       types.StringType,
+	"completed_at":     types.StringType,
+}
+
+// Metadata returns the data source type name.
+func (d *restoreJobsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore_jobs"
+}
+
+// Schema defines the schema for the data source.
+func (d *restoreJobsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the restore jobs of the project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the restore job list.",
+				Computed:    true,
+			},
+			"backup_id": schema.StringAttribute{
+				Description: "Only list the restore jobs of this backup.",
+				Optional:    true,
+			},
+			"restore_jobs": schema.ListNestedAttribute{
+				Description: "The restore jobs, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the restore job.",
+							Computed:    true,
+						},
+						"backup_id": schema.StringAttribute{
+							Description: "The ID of the restored backup.",
+							Computed:    true,
+						},
+						"index_name": schema.StringAttribute{
+							Description: "The name of the index the backup is restored into.",
+							Computed:    true,
+						},
+						"index_id": schema.StringAttribute{
+							Description: "The ID of the index the backup is restored into.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the restore job, e.g. Completed.",
+							Computed:    true,
+						},
+						"percent_complete": schema.Float64Attribute{
+							Description: "How much of the backup has been restored, in percent.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the restore job was started.",
+							Computed:    true,
+						},
+						"completed_at": schema.StringAttribute{
+							Description: "When the restore job completed, if it did.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *restoreJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data restoreJobsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobs, err := d.client.ListRestoreJobs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error ListRestoreJobs", err.Error())
+		return
+	}
+	sortRestoreJobs(jobs)
+
+	elements := make([]attr.Value, 0, len(jobs))
+	for _, job := range jobs {
+		if !data.BackupID.IsNull() && job.BackupID != data.BackupID.ValueString() {
+			continue
+		}
+		object, diags := types.ObjectValue(restoreJobAttributeTypes, map[string]attr.Value{
+			"id":               types.StringValue(job.ID),
+			"backup_id":        types.StringValue(job.BackupID),
+			"index_name":       types.StringValue(job.TargetIndexName),
+			"index_id":         types.StringValue(job.TargetIndexID),
+			"status":           types.StringValue(job.Status),
+			"percent_complete": types.Float64Value(job.PercentComplete),
+			"created_at":       types.StringValue(job.CreatedAt),
+			"completed_at":     types.StringValue(job.CompletedAt),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elements = append(elements, object)
+	}
+	jobsValue, diags := types.ListValue(types.ObjectType{AttrTypes: restoreJobAttributeTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("restore_jobs")
+	if !data.BackupID.IsNull() {
+		data.ID = types.StringValue(data.BackupID.ValueString())
+	}
+	data.RestoreJobs = jobsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *restoreJobsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	d.client = data.client
+}
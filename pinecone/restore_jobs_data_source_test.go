@@ -0,0 +1,59 @@
+package pinecone
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRestoreJobsDataSource(t *testing.T) {
+	ctx := context.Background()
+	cli := newMockClientWithMovies(t)
+	nightly, err := cli.CreateBackup(ctx, "movies", CreateBackupRequest{Name: "nightly"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	weekly, err := cli.CreateBackup(ctx, "movies", CreateBackupRequest{Name: "weekly"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, restore := range []struct{ backupID, name string }{
+		{nightly.ID, "movies-monday"},
+		{nightly.ID, "movies-tuesday"},
+		{weekly.ID, "movies-week"},
+	} {
+		if _, err := cli.CreateIndexFromBackup(ctx, restore.backupID, CreateIndexFromBackupRequest{Name: restore.name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pinecone_restore_jobs" "all" {}
+
+data "pinecone_restore_jobs" "nightly" {
+    backup_id = "mock-backup-1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.all", "id", "restore_jobs"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.all", "restore_jobs.#", "3"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.all", "restore_jobs.2.index_name", "movies-week"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.all", "restore_jobs.2.backup_id", "mock-backup-2"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "id", "mock-backup-1"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "restore_jobs.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "restore_jobs.0.id", "mock-restore-job-3"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "restore_jobs.0.index_name", "movies-monday"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "restore_jobs.0.index_id", "movies-monday-mock"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "restore_jobs.0.status", "Completed"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "restore_jobs.0.percent_complete", "100"),
+					resource.TestCheckResourceAttr("data.pinecone_restore_jobs.nightly", "restore_jobs.1.index_name", "movies-tuesday"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &scalePodTypeFunction{}
+)
+
+// NewScalePodTypeFunction is a helper function to simplify the provider implementation.
+func NewScalePodTypeFunction() function.Function {
+	return &scalePodTypeFunction{}
+}
+
+// scalePodTypeFunction is the function implementation.
+type scalePodTypeFunction struct{}
+
+// Metadata returns the function name.
+func (f *scalePodTypeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "scale_pod_type"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *scalePodTypeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Scale a pod type up one size.",
+		Description: "Return the next size up in the same pod class, e.g. \"p1.x2\" for \"p1.x1\". Fails for x8 pods, which are the largest size.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "pod_type",
+				Description: "The pod type to scale up.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run scales the pod type up.
+func (f *scalePodTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var podTypeStr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &podTypeStr))
+	if resp.Error != nil {
+		return
+	}
+
+	podType, err := NewPodType(podTypeStr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	scaled, err := podType.ScaleUp()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, scaled.String()))
+}
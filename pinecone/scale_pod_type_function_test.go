@@ -0,0 +1,53 @@
+package pinecone
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestScalePodTypeFunction(t *testing.T) {
+	result, err := runFunction(NewScalePodTypeFunction(), types.StringUnknown(), types.StringValue("p1.x2"))
+	if err != nil {
+		t.Fatalf("expected no error, but received error: %v", err)
+	}
+	if !result.Equal(types.StringValue("p1.x4")) {
+		t.Fatalf("expected p1.x4, but received %v", result)
+	}
+
+	if _, err := runFunction(NewScalePodTypeFunction(), types.StringUnknown(), types.StringValue("p1.x8")); err == nil {
+		t.Fatal("expected an error when scaling the largest size")
+	}
+}
+
+func TestAccScalePodTypeFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+    value = provider::pinecone::scale_pod_type("s1.x1")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s1.x2"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+    value = provider::pinecone::scale_pod_type("s1.x8")
+}
+`,
+				ExpectError: regexp.MustCompile(`already the\s+largest size`),
+			},
+		},
+	})
+}
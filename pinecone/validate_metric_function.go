@@ -0,0 +1,52 @@
+package pinecone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &validateMetricFunction{}
+)
+
+// NewValidateMetricFunction is a helper function to simplify the provider implementation.
+func NewValidateMetricFunction() function.Function {
+	return &validateMetricFunction{}
+}
+
+// validateMetricFunction is the function implementation.
+type validateMetricFunction struct{}
+
+// Metadata returns the function name.
+func (f *validateMetricFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_metric"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *validateMetricFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether a string is a valid metric.",
+		Description: "Return true if the metric is one Pinecone accepts (euclidean, cosine or dotproduct), for use in variable validation blocks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "metric",
+				Description: "The metric to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks the metric.
+func (f *validateMetricFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var metricStr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &metricStr))
+	if resp.Error != nil {
+		return
+	}
+
+	_, err := NewMetric(metricStr)
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, err == nil))
+}
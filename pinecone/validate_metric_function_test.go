@@ -0,0 +1,53 @@
+package pinecone
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestValidateMetricFunction(t *testing.T) {
+	testCases := []struct {
+		metric   string
+		expected bool
+	}{
+		{metric: "cosine", expected: true},
+		{metric: "dotproduct", expected: true},
+		{metric: "manhattan", expected: false},
+	}
+
+	for _, tC := range testCases {
+		result, err := runFunction(NewValidateMetricFunction(), types.BoolUnknown(), types.StringValue(tC.metric))
+		if err != nil || !result.Equal(types.BoolValue(tC.expected)) {
+			t.Errorf("validate_metric(%s) = (%v, %v), expected %v", tC.metric, result, err, tC.expected)
+		}
+	}
+}
+
+func TestAccValidateMetricFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "valid" {
+    value = provider::pinecone::validate_metric("euclidean")
+}
+
+output "invalid" {
+    value = provider::pinecone::validate_metric("hamming")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", "true"),
+					resource.TestCheckOutput("invalid", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,193 @@
+package pinecone
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	VectorsFormatJSONL = "jsonl"
+	VectorsFormatCSV   = "csv"
+)
+
+// vectorRecord is one vector of a vectors file, in the field names of the Pinecone clients.
+type vectorRecord struct {
+	ID           string         `json:"id"`
+	Values       []float32      `json:"values,omitempty"`
+	SparseValues *SparseValues  `json:"sparse_values,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+}
+
+func (r vectorRecord) vector() Vector {
+	return Vector{
+		ID:           r.ID,
+		Values:       r.Values,
+		SparseValues: r.SparseValues,
+		Metadata:     r.Metadata,
+	}
+}
+
+// VectorsFile is the content of a vectors file.
+type VectorsFile struct {
+	Vectors []Vector
+	// Hash is the SHA-256 of the file content.
+	Hash string
+}
+
+// VectorsFormatFromPath infers the format of a vectors file from its extension.
+func VectorsFormatFromPath(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return VectorsFormatJSONL, nil
+	case ".csv":
+		return VectorsFormatCSV, nil
+	default:
+		return "", fmt.Errorf("error: cannot infer the format of %s from its extension, set format to %s or %s", path, VectorsFormatJSONL, VectorsFormatCSV)
+	}
+}
+
+// LoadVectorsFile reads the vectors of a JSONL or CSV file. Each JSONL line is an
+// object with id, values, sparse_values and metadata. A CSV file has a header
+// row with an id column and optional values, sparse_values and metadata columns
+// holding JSON.
+func LoadVectorsFile(path string, format string) (*VectorsFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var vectors []Vector
+	switch format {
+	case VectorsFormatJSONL:
+		vectors, err = parseVectorsJSONL(data)
+	case VectorsFormatCSV:
+		vectors, err = parseVectorsCSV(data)
+	default:
+		err = fmt.Errorf("error: invalid format %q, must be %s or %s", format, VectorsFormatJSONL, VectorsFormatCSV)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error: %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool, len(vectors))
+	for _, v := range vectors {
+		if v.ID == "" {
+			return nil, fmt.Errorf("error: %s: a vector has no id", path)
+		}
+		if seen[v.ID] {
+			return nil, fmt.Errorf("error: %s: duplicate vector id %q", path, v.ID)
+		}
+		seen[v.ID] = true
+		if v.SparseValues != nil {
+			if err := v.SparseValues.Validate(); err != nil {
+				return nil, fmt.Errorf("error: %s: vector %q: %w", path, v.ID, err)
+			}
+		}
+	}
+
+	sum := sha256.Sum256(data)
+	return &VectorsFile{
+		Vectors: vectors,
+		Hash:    hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+func parseVectorsJSONL(data []byte) ([]Vector, error) {
+	var vectors []Vector
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var record vectorRecord
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		vectors = append(vectors, record.vector())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vectors, nil
+}
+
+func parseVectorsCSV(data []byte) ([]Vector, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "id", "values", "sparse_values", "metadata":
+		default:
+			return nil, fmt.Errorf("unknown column %q, must be one of id, values, sparse_values and metadata", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["id"]; !ok {
+		return nil, errors.New("missing id column")
+	}
+
+	var vectors []Vector
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		record := vectorRecord{ID: row[columns["id"]]}
+		fields := map[string]any{
+			"values":        &record.Values,
+			"sparse_values": &record.SparseValues,
+			"metadata":      &record.Metadata,
+		}
+		for name, target := range fields {
+			i, ok := columns[name]
+			if !ok || strings.TrimSpace(row[i]) == "" {
+				continue
+			}
+			if err := json.Unmarshal([]byte(row[i]), target); err != nil {
+				return nil, fmt.Errorf("line %d: column %s: %w", line, name, err)
+			}
+		}
+		vectors = append(vectors, record.vector())
+	}
+	return vectors, nil
+}
+
+// vectorHash returns a digest of everything Pinecone stores for a vector, so
+// changed vectors can be told apart from unchanged ones.
+func vectorHash(v Vector) string {
+	// json.Marshal sorts map keys, so equal vectors always hash the same.
+	data, _ := json.Marshal(v)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16])
+}
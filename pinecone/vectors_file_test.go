@@ -0,0 +1,106 @@
+package pinecone
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeTestFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestVectorsFormatFromPath(t *testing.T) {
+	tests := map[string]string{
+		"vectors.jsonl":  VectorsFormatJSONL,
+		"vectors.NDJSON": VectorsFormatJSONL,
+		"dir/v.csv":      VectorsFormatCSV,
+	}
+	for path, expected := range tests {
+		format, err := VectorsFormatFromPath(path)
+		if err != nil || format != expected {
+			t.Fatalf("expected %s for %s, but received %s, %v", expected, path, format, err)
+		}
+	}
+	if _, err := VectorsFormatFromPath("vectors.json"); err == nil {
+		t.Fatal("expected an error for an unknown extension")
+	}
+}
+
+func TestLoadVectorsFile(t *testing.T) {
+	expected := []Vector{
+		{ID: "a", Values: []float32{0.1, 0.2}, Metadata: map[string]any{"genre": "drama"}},
+		{ID: "b", Values: []float32{0.3, 0.4}, SparseValues: &SparseValues{Indices: []uint32{1, 7}, Values: []float32{0.5, 0.6}}},
+	}
+
+	jsonl := writeTestFile(t, "vectors.jsonl", `{"id":"a","values":[0.1,0.2],"metadata":{"genre":"drama"}}
+
+{"id":"b","values":[0.3,0.4],"sparse_values":{"indices":[1,7],"values":[0.5,0.6]}}
+`)
+	file, err := LoadVectorsFile(jsonl, VectorsFormatJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(file.Vectors, expected) {
+		t.Fatalf("expected %+v, but received %+v", expected, file.Vectors)
+	}
+	if len(file.Hash) != 64 {
+		t.Fatalf("expected a SHA-256 hex digest, but received %q", file.Hash)
+	}
+
+	csv := writeTestFile(t, "vectors.csv", `id,values,sparse_values,metadata
+a,"[0.1,0.2]",,"{""genre"":""drama""}"
+b,"[0.3,0.4]","{""indices"":[1,7],""values"":[0.5,0.6]}",
+`)
+	file, err = LoadVectorsFile(csv, VectorsFormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(file.Vectors, expected) {
+		t.Fatalf("expected %+v, but received %+v", expected, file.Vectors)
+	}
+}
+
+func TestLoadVectorsFileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		content  string
+		expected string
+	}{
+		{"v.jsonl", VectorsFormatJSONL, `{"id":"a","values":[1]}` + "\n" + `{"id":"a","values":[2]}`, `duplicate vector id "a"`},
+		{"v.jsonl", VectorsFormatJSONL, `{"values":[1]}`, "a vector has no id"},
+		{"v.jsonl", VectorsFormatJSONL, `{"id":"a"}` + "\n" + `{"id":"b","vals":[1]}`, "line 2"},
+		{"v.csv", VectorsFormatCSV, "values\n[1]\n", "missing id column"},
+		{"v.csv", VectorsFormatCSV, "id,score\na,1\n", `unknown column "score"`},
+		{"v.csv", VectorsFormatCSV, "id,values\na,[1]\nb,oops\n", "line 3: column values"},
+		{"v.jsonl", VectorsFormatJSONL, `{"id":"a","sparse_values":{"indices":[1,2],"values":[1]}}`, `vector "a": error: sparse values have 2 indices but 1 values`},
+		{"v.txt", "txt", "", `invalid format "txt"`},
+	}
+	for _, test := range tests {
+		path := writeTestFile(t, test.name, test.content)
+		_, err := LoadVectorsFile(path, test.format)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Fatalf("expected an error containing %q, but received %v", test.expected, err)
+		}
+	}
+}
+
+func TestVectorHash(t *testing.T) {
+	a := Vector{ID: "a", Values: []float32{1, 2}, Metadata: map[string]any{"x": 1.0, "y": "z"}}
+	b := Vector{ID: "a", Values: []float32{1, 2}, Metadata: map[string]any{"y": "z", "x": 1.0}}
+	if vectorHash(a) != vectorHash(b) {
+		t.Fatal("expected equal vectors to hash the same")
+	}
+	b.Metadata["x"] = 2.0
+	if vectorHash(a) == vectorHash(b) {
+		t.Fatal("expected different metadata to change the hash")
+	}
+}
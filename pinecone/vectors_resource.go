@@ -0,0 +1,550 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultUpsertBatchSize = 100
+	defaultParallelism     = 4
+	maxUpsertBatchSize     = 1000
+	// Pinecone deletes at most 1000 IDs per request.
+	deleteBatchSize = 1000
+	// IDs are sent in the query string, so fetch fewer at a time.
+	fetchBatchSize = 100
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vectorsResource{}
+	_ resource.ResourceWithConfigure      = &vectorsResource{}
+	_ resource.ResourceWithModifyPlan     = &vectorsResource{}
+	_ resource.ResourceWithValidateConfig = &vectorsResource{}
+)
+
+// NewVectorsResource is a helper function to simplify the provider implementation.
+func NewVectorsResource() resource.Resource {
+	return &vectorsResource{}
+}
+
+// vectorsResource is the resource implementation.
+type vectorsResource struct {
+	client PineconeClientInterface
+}
+
+type vectorsResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	IndexName    types.String `tfsdk:"index_name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	Source       types.String `tfsdk:"source"`
+	Format       types.String `tfsdk:"format"`
+	BatchSize    types.Int64  `tfsdk:"batch_size"`
+	Parallelism  types.Int64  `tfsdk:"parallelism"`
+	ContentHash  types.String `tfsdk:"content_hash"`
+	VectorCount  types.Int64  `tfsdk:"vector_count"`
+	VectorHashes types.Map    `tfsdk:"vector_hashes"`
+}
+
+// Metadata returns the resource type name.
+func (r *vectorsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vectors"
+}
+
+// Schema defines the schema for the resource.
+func (r *vectorsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Upsert the vectors of a local JSONL or CSV file into a namespace of an index. " +
+			"Only vectors that changed in the file are upserted again, vectors removed from the file are deleted, " +
+			"and all vectors of the file are deleted on destroy. Meant for fixtures and demo data, as the state holds a hash per vector.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the vectors, index_name/namespace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace to upsert the vectors into. Defaults to the default namespace \"\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of the file to read the vectors from. Each JSONL line is an object with id, values, " +
+					"sparse_values ({indices, values}) and metadata. A CSV file has a header row with an id column and " +
+					"optional values, sparse_values and metadata columns holding JSON.",
+				Required: true,
+			},
+			"format": schema.StringAttribute{
+				Description: "The format of the file, jsonl or csv. Inferred from the extension of source by default.",
+				Optional:    true,
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "The number of vectors per upsert request.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultUpsertBatchSize),
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: "The number of requests to send at once.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultParallelism),
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "The SHA-256 of the file content.",
+				Computed:    true,
+			},
+			"vector_count": schema.Int64Attribute{
+				Description: "The number of vectors managed by the resource.",
+				Computed:    true,
+			},
+			"vector_hashes": schema.MapAttribute{
+				Description: "A hash of each managed vector by ID. A vector missing from the index is dropped on refresh, so the next apply upserts it again.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the format and the batching settings.
+func (r *vectorsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vectorsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Format.IsNull() && !config.Format.IsUnknown() {
+		switch config.Format.ValueString() {
+		case VectorsFormatJSONL, VectorsFormatCSV:
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("format"), "Invalid format",
+				fmt.Sprintf("format must be %s or %s, got %q.", VectorsFormatJSONL, VectorsFormatCSV, config.Format.ValueString()))
+		}
+	}
+	if !config.BatchSize.IsNull() && !config.BatchSize.IsUnknown() {
+		if v := config.BatchSize.ValueInt64(); v < 1 || v > maxUpsertBatchSize {
+			resp.Diagnostics.AddAttributeError(path.Root("batch_size"), "Invalid batch size",
+				fmt.Sprintf("batch_size must be between 1 and %d, got %d.", maxUpsertBatchSize, v))
+		}
+	}
+	if !config.Parallelism.IsNull() && !config.Parallelism.IsUnknown() {
+		if v := config.Parallelism.ValueInt64(); v < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("parallelism"), "Invalid parallelism",
+				fmt.Sprintf("parallelism must be at least 1, got %d.", v))
+		}
+	}
+}
+
+// vectorsFormat returns the configured format or the one inferred from the source.
+func vectorsFormat(model vectorsResourceModel) (string, error) {
+	if !model.Format.IsNull() {
+		return model.Format.ValueString(), nil
+	}
+	return VectorsFormatFromPath(model.Source.ValueString())
+}
+
+// loadVectorsSource reads the vectors file of the model and their hashes by ID.
+func loadVectorsSource(model vectorsResourceModel) (*VectorsFile, map[string]string, error) {
+	format, err := vectorsFormat(model)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := LoadVectorsFile(model.Source.ValueString(), format)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hashes := make(map[string]string, len(file.Vectors))
+	for _, v := range file.Vectors {
+		hashes[v.ID] = vectorHash(v)
+	}
+	return file, hashes, nil
+}
+
+// ModifyPlan reads the source file, so that a change to its content shows up in the plan.
+func (r *vectorsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to read when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vectorsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The file is read on the next plan when its path is known only after apply.
+	if plan.Source.IsUnknown() || plan.Format.IsUnknown() {
+		return
+	}
+
+	file, hashes, err := loadVectorsSource(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid vectors file", err.Error())
+		return
+	}
+
+	// Sparse values need a dotproduct index. An index created in the same apply is checked by apply.
+	if hasSparseValues(file.Vectors) && !plan.IndexName.IsUnknown() {
+		index, err := r.client.DescribeIndex(ctx, plan.IndexName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error checking index metric",
+				"Could not describe index, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if index != nil {
+			if err := checkSparseMetric(index.Database.Name, index.Database.Metric); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid vectors file", err.Error())
+				return
+			}
+		}
+	}
+
+	vectorHashes, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringValue(file.Hash))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("vector_count"), types.Int64Value(int64(len(hashes))))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("vector_hashes"), vectorHashes)...)
+}
+
+// hasSparseValues reports whether any of the vectors has sparse values.
+func hasSparseValues(vectors []Vector) bool {
+	for _, v := range vectors {
+		if v.SparseValues != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// upsertVectors upserts vectors in batches of batchSize, parallelism batches at a time.
+func upsertVectors(ctx context.Context, client DataPlaneClientInterface, indexName string, namespace string, vectors []Vector, batchSize int, parallelism int) error {
+	return forEachBatch(ctx, len(vectors), batchSize, parallelism, func(ctx context.Context, start int, end int) error {
+		_, err := client.Upsert(ctx, indexName, UpsertRequest{
+			Vectors:   vectors[start:end],
+			Namespace: namespace,
+		})
+		return err
+	})
+}
+
+// deleteVectors deletes vectors by ID, parallelism requests at a time.
+func deleteVectors(ctx context.Context, client DataPlaneClientInterface, indexName string, namespace string, ids []string, parallelism int) error {
+	return forEachBatch(ctx, len(ids), deleteBatchSize, parallelism, func(ctx context.Context, start int, end int) error {
+		return client.Delete(ctx, indexName, DeleteRequest{
+			IDs:       ids[start:end],
+			Namespace: namespace,
+		})
+	})
+}
+
+// existingVectorIDs returns which of ids are stored in the namespace.
+func existingVectorIDs(ctx context.Context, client DataPlaneClientInterface, indexName string, namespace string, ids []string, parallelism int) (map[string]bool, error) {
+	var mutex sync.Mutex
+	existing := make(map[string]bool, len(ids))
+	err := forEachBatch(ctx, len(ids), fetchBatchSize, parallelism, func(ctx context.Context, start int, end int) error {
+		fetched, err := client.Fetch(ctx, indexName, FetchRequest{
+			IDs:       ids[start:end],
+			Namespace: namespace,
+		})
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		for id := range fetched.Vectors {
+			existing[id] = true
+		}
+		return nil
+	})
+	return existing, err
+}
+
+// stateVectorHashes returns the vector hashes of a model by ID.
+func stateVectorHashes(ctx context.Context, model vectorsResourceModel) (map[string]string, error) {
+	hashes := make(map[string]string)
+	if model.VectorHashes.IsNull() || model.VectorHashes.IsUnknown() {
+		return hashes, nil
+	}
+	if diags := model.VectorHashes.ElementsAs(ctx, &hashes, false); diags.HasError() {
+		return nil, fmt.Errorf("error: invalid vector_hashes")
+	}
+	return hashes, nil
+}
+
+// sortedKeys returns the keys of m in order, so requests are deterministic.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// apply upserts the vectors of the source whose hash differs from current and
+// deletes the vectors of current that are no longer in the source.
+func (r *vectorsResource) apply(ctx context.Context, plan *vectorsResourceModel, current map[string]string) error {
+	file, hashes, err := loadVectorsSource(*plan)
+	if err != nil {
+		return err
+	}
+	if !plan.ContentHash.IsUnknown() && plan.ContentHash.ValueString() != file.Hash {
+		return fmt.Errorf("error: %s changed after the plan was made, run terraform plan again", plan.Source.ValueString())
+	}
+
+	var changed []Vector
+	for _, v := range file.Vectors {
+		if current[v.ID] != hashes[v.ID] {
+			changed = append(changed, v)
+		}
+	}
+	var removed []string
+	for _, id := range sortedKeys(current) {
+		if _, ok := hashes[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	indexName := plan.IndexName.ValueString()
+	namespace := plan.Namespace.ValueString()
+	parallelism := int(plan.Parallelism.ValueInt64())
+	if hasSparseValues(changed) {
+		index, err := r.client.DescribeIndex(ctx, indexName)
+		if err != nil {
+			return err
+		}
+		if index == nil {
+			return fmt.Errorf("error: index %s not found", indexName)
+		}
+		if err := checkSparseMetric(index.Database.Name, index.Database.Metric); err != nil {
+			return err
+		}
+	}
+	if err := upsertVectors(ctx, r.client, indexName, namespace, changed, int(plan.BatchSize.ValueInt64()), parallelism); err != nil {
+		return err
+	}
+	if err := deleteVectors(ctx, r.client, indexName, namespace, removed, parallelism); err != nil {
+		return err
+	}
+
+	vectorHashes, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	if diags.HasError() {
+		return fmt.Errorf("error: invalid vector_hashes")
+	}
+	plan.ID = types.StringValue(indexName + "/" + namespace)
+	plan.ContentHash = types.StringValue(file.Hash)
+	plan.VectorCount = types.Int64Value(int64(len(hashes)))
+	plan.VectorHashes = vectorHashes
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vectorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan vectorsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan, map[string]string{}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error upserting vectors",
+			"Could not upsert vectors, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vectorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state vectorsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.DescribeIndex(ctx, state.IndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Vectors",
+			"Could not read Pinecone Vectors, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// If index is nil, then the index and its vectors have been deleted
+	if index == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	hashes, err := stateVectorHashes(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Vectors",
+			"Could not read Pinecone Vectors, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	existing, err := existingVectorIDs(ctx, r.client, state.IndexName.ValueString(), state.Namespace.ValueString(), sortedKeys(hashes), int(state.Parallelism.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pinecone Vectors",
+			"Could not read Pinecone Vectors, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Forget vectors deleted outside Terraform, so the next apply upserts them again.
+	for id := range hashes {
+		if !existing[id] {
+			delete(hashes, id)
+		}
+	}
+	vectorHashes, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.VectorHashes = vectorHashes
+	state.VectorCount = types.Int64Value(int64(len(hashes)))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vectorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan vectorsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state vectorsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := stateVectorHashes(ctx, state)
+	if err == nil {
+		err = r.apply(ctx, &plan, current)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error upserting vectors",
+			"Could not upsert vectors, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vectorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state vectorsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The vectors are already gone if the index is.
+	index, err := r.client.DescribeIndex(ctx, state.IndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting vectors",
+			"Could not delete vectors, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if index == nil {
+		return
+	}
+
+	hashes, err := stateVectorHashes(ctx, state)
+	if err == nil {
+		err = deleteVectors(ctx, r.client, state.IndexName.ValueString(), state.Namespace.ValueString(), sortedKeys(hashes), int(state.Parallelism.ValueInt64()))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting vectors",
+			"Could not delete vectors, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vectorsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*pineconeProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error Configure", "Invalid provider data")
+		return
+	}
+	r.client = data.client
+}
@@ -0,0 +1,267 @@
+package pinecone
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// upsertRecordingClient records the IDs of every upserted vector.
+type upsertRecordingClient struct {
+	*MockPineconeClient
+
+	mutex    sync.Mutex
+	upserted []string
+}
+
+func (c *upsertRecordingClient) Upsert(ctx context.Context, indexName string, req UpsertRequest) (*UpsertResponse, error) {
+	c.mutex.Lock()
+	for _, v := range req.Vectors {
+		c.upserted = append(c.upserted, v.ID)
+	}
+	c.mutex.Unlock()
+	return c.MockPineconeClient.Upsert(ctx, indexName, req)
+}
+
+// checkUpserted checks the vectors upserted since the last check.
+func (c *upsertRecordingClient) checkUpserted(expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		upserted := c.upserted
+		c.upserted = nil
+		sort.Strings(upserted)
+		if len(upserted) == 0 && len(expected) == 0 {
+			return nil
+		}
+		if !reflect.DeepEqual(upserted, expected) {
+			return fmt.Errorf("expected upserted vectors %v, but received %v", expected, upserted)
+		}
+		return nil
+	}
+}
+
+func (c *upsertRecordingClient) checkStored(namespace string, expected map[string][]float32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		stats, err := c.DescribeIndexStats(context.Background(), "test", DescribeIndexStatsRequest{})
+		if err != nil {
+			return err
+		}
+		if count := stats.Namespaces[namespace].VectorCount; count != len(expected) {
+			return fmt.Errorf("expected %d vectors in namespace %q, but found %d", len(expected), namespace, count)
+		}
+		for id, values := range expected {
+			fetched, err := c.Fetch(context.Background(), "test", FetchRequest{IDs: []string{id}, Namespace: namespace})
+			if err != nil {
+				return err
+			}
+			if v, ok := fetched.Vectors[id]; !ok || !reflect.DeepEqual(v.Values, values) {
+				return fmt.Errorf("expected vector %s to have values %v, but found %+v", id, values, fetched.Vectors[id])
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccVectorsResource(t *testing.T) {
+	ctx := context.Background()
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.CreateIndex(ctx, CreateIndexRequest{Name: "test", Dimension: 2}); err != nil {
+		t.Fatal(err)
+	}
+	cli := &upsertRecordingClient{MockPineconeClient: mock}
+
+	source := filepath.Join(t.TempDir(), "fixtures.jsonl")
+	writeSource := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	config := providerConfig + fmt.Sprintf(`
+resource "pinecone_vectors" "test" {
+    index_name  = "test"
+    namespace   = "fixtures"
+    source      = %q
+    batch_size  = 1
+    parallelism = 2
+}
+`, source)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		CheckDestroy: func(s *terraform.State) error {
+			return cli.checkStored("fixtures", map[string][]float32{})(s)
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: writeSource(`{"id":"a","values":[1,0],"metadata":{"genre":"drama"}}
+{"id":"b","values":[0,1]}
+`),
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_vectors.test", "id", "test/fixtures"),
+					resource.TestCheckResourceAttr("pinecone_vectors.test", "vector_count", "2"),
+					resource.TestCheckResourceAttr("pinecone_vectors.test", "vector_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet("pinecone_vectors.test", "content_hash"),
+					cli.checkUpserted("a", "b"),
+					cli.checkStored("fixtures", map[string][]float32{"a": {1, 0}, "b": {0, 1}}),
+				),
+			},
+			// Update upserts only changed vectors and deletes removed ones
+			{
+				PreConfig: writeSource(`{"id":"a","values":[1,0],"metadata":{"genre":"drama"}}
+{"id":"c","values":[1,1]}
+`),
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_vectors.test", "vector_count", "2"),
+					resource.TestCheckResourceAttrSet("pinecone_vectors.test", "vector_hashes.c"),
+					resource.TestCheckNoResourceAttr("pinecone_vectors.test", "vector_hashes.b"),
+					cli.checkUpserted("c"),
+					cli.checkStored("fixtures", map[string][]float32{"a": {1, 0}, "c": {1, 1}}),
+				),
+			},
+			// A vector deleted outside Terraform is upserted again
+			{
+				PreConfig: func() {
+					_ = mock.Delete(ctx, "test", DeleteRequest{Namespace: "fixtures", IDs: []string{"a"}})
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cli.checkUpserted("a"),
+					cli.checkStored("fixtures", map[string][]float32{"a": {1, 0}, "c": {1, 1}}),
+				),
+			},
+			// An invalid file fails the plan
+			{
+				PreConfig: writeSource(`{"id":"a","values":[1,0]}
+{"id":"a","values":[0,1]}
+`),
+				Config:      config,
+				ExpectError: regexp.MustCompile(`duplicate\s+vector\s+id\s+"a"`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVectorsResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pinecone_vectors" "test" {
+    index_name = "test"
+    source     = "fixtures.json"
+    format     = "parquet"
+    batch_size = 5000
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)format\s+must\s+be\s+jsonl\s+or\s+csv.*batch_size\s+must\s+be\s+between\s+1\s+and\s+1000`),
+			},
+		},
+	})
+}
+
+func TestAccVectorsResourceSparseValues(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "cosine", Dimension: 2, Metric: MetricCosine})
+	_ = cli.CreateIndex(ctx, CreateIndexRequest{Name: "hybrid", Dimension: 2, Metric: MetricDotProduct})
+
+	source := writeTestFile(t, "fixtures.jsonl", `{"id":"a","values":[1,0],"sparse_values":{"indices":[3],"values":[0.5]}}
+`)
+	config := func(indexName string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "pinecone_vectors" "test" {
+    index_name = %q
+    source     = %q
+}
+`, indexName, source)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// Sparse values fail the plan for an index without the dotproduct metric
+			{
+				Config:      config("cosine"),
+				ExpectError: regexp.MustCompile(`index\s+cosine\s+has\s+metric\s+cosine,\s+but\s+sparse\s+values\s+require\s+dotproduct`),
+			},
+			{
+				Config: config("hybrid"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_vectors.test", "vector_count", "1"),
+					func(s *terraform.State) error {
+						fetched, err := cli.Fetch(ctx, "hybrid", FetchRequest{IDs: []string{"a"}})
+						if err != nil {
+							return err
+						}
+						expected := &SparseValues{Indices: []uint32{3}, Values: []float32{0.5}}
+						if !reflect.DeepEqual(fetched.Vectors["a"].SparseValues, expected) {
+							return fmt.Errorf("expected sparse values %+v, but found %+v", expected, fetched.Vectors["a"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccVectorsResourceSparseValuesNewIndex(t *testing.T) {
+	mock, err := NewMockClient(WithAPIKey("test_api_key"), WithEnvironment("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli := &upsertRecordingClient{MockPineconeClient: mock}
+
+	source := writeTestFile(t, "fixtures.jsonl", `{"id":"a","values":[1,0],"sparse_values":{"indices":[3],"values":[0.5]}}
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithClient(cli),
+		Steps: []resource.TestStep{
+			// The metric of an index created in the same apply is checked before upserting.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "pinecone_index" "cosine" {
+    name      = "cosine"
+    dimension = 2
+    metric    = "cosine"
+}
+
+resource "pinecone_vectors" "test" {
+    index_name = pinecone_index.cosine.id
+    source     = %q
+}
+`, source),
+				ExpectError: regexp.MustCompile(`index\s+cosine\s+has\s+metric\s+cosine,\s+but\s+sparse\s+values\s+require\s+dotproduct`),
+			},
+		},
+	})
+
+	if len(cli.upserted) != 0 {
+		t.Fatalf("expected no upserts, but received %v", cli.upserted)
+	}
+}